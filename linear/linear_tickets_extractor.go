@@ -1,23 +1,159 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 const (
-	linearAPIURL = "https://api.linear.app/graphql"
-	startDate    = "2025-01-01T00:00:00.000Z"
-	endDate      = "2026-02-28T23:59:59.999Z"
+	defaultLinearAPIURL = "https://api.linear.app/graphql"
+	startDate           = "2025-01-01T00:00:00.000Z"
+	endDate             = "2026-02-28T23:59:59.999Z"
+
+	// schemaCacheTTL is how long the introspected schema is reused for --validate-schema
+	schemaCacheTTL = 24 * time.Hour
+
+	// forecastHistoryWeeks is how many recent weeks the velocity forecast is fitted
+	// to; forecastWeeks is how many weeks ahead it projects
+	forecastHistoryWeeks = 8
+	forecastWeeks        = 4
+
+	// previewRecordLimit is the number of records printed per format in preview mode
+	previewRecordLimit = 5
+
+	// slackPostMessageURL is Slack's chat.postMessage endpoint; slackPostInterval
+	// keeps posting under the Tier-1 rate limit of one message per second
+	slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+	slackPostInterval   = time.Second
+
+	// maxSlackIssuesPerMessage keeps batched messages under Slack's 50-block limit
+	maxSlackIssuesPerMessage = 16
+
+	// defaultPageSize is the number of records requested per page; smokeTestPageSize
+	// is used instead when pagination is disabled
+	defaultPageSize   = 100
+	smokeTestPageSize = 5
 )
 
+// Config holds the runtime options parsed from command-line flags
+type Config struct {
+	Preview      bool
+	NoPagination bool
+	TeamKeys     stringSliceFlag
+	Weekly       bool
+	WeekStart    time.Weekday
+	Location     *time.Location
+
+	Interactive         bool
+	NoColor             bool
+	NoCharts            bool
+	ValidateSchema      bool
+	TrackReassignments  bool
+	DetectReestimates   bool
+	ShowTeamBreakdown   bool
+	CycleAnalysis       bool
+	ProjectCompletion   bool
+	NoUrgentIssues      bool
+	CustomFields        bool
+	ICSOut              string
+	StateDurations      bool
+	SlackChannel        string
+	SlackBatchSize      int
+	MinDescriptionWords int
+	LinearKeyFile       string
+	LinearAPIURL        string
+	LinearInsecure      bool
+	SortBy              string
+	MinSubscribers      int
+	FilterSubscriber    string
+	LabelDepth          int
+	ShowOverdueOnly     bool
+	TagAs               string
+	FindMentions        bool
+	VelocityRatio       float64
+	CycleDays           float64
+
+	// DescriptionTemplates are the sections every description must match
+	DescriptionTemplates []*regexp.Regexp
+
+	// ImpactWeights weights the terms of each issue's impact score
+	ImpactWeights impactWeights
+
+	// SLA maps a Linear priority to the time allowed to complete an issue
+	SLA             map[int]time.Duration
+	DryRunMutations bool
+
+	// PeriodStart and PeriodEnd bound completedAt in the issue filter. They default
+	// to startDate and endDate and are shifted back for --compare-previous-period.
+	PeriodStart           string
+	PeriodEnd             string
+	ComparePreviousPeriod bool
+
+	// Comparison holds the current and previous period totals once both are fetched
+	Comparison *periodComparison
+
+	// CycleRanges holds the cycles overlapping the issues' lifetimes when
+	// --cycle-analysis is set, to detect overruns and measure each issue's share of its cycle
+	CycleRanges []Cycle
+
+	// TeamBreakdown holds per-member completions across the teams when --show-team-breakdown is set
+	TeamBreakdown []memberCompletions
+
+	// StateTypeCounts counts the period's issues by state type for the summary chart
+	StateTypeCounts map[string]int
+
+	// Fields lists the compactIssue JSON tags to export as CSV columns, in order
+	Fields []string
+
+	// Teams caches the workspace teams fetched during validation
+	Teams []Team
+}
+
+// pageSize returns the number of records to request per page
+func (c *Config) pageSize() int {
+	if c.NoPagination {
+		return smokeTestPageSize
+	}
+	return defaultPageSize
+}
+
+// stringSliceFlag is a flag.Value that collects repeated string flags
+type stringSliceFlag []string
+
+func (f *stringSliceFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringSliceFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 // GraphQL Response Structures
 type GraphQLResponse struct {
 	Data   Data    `json:"data"`
@@ -30,12 +166,34 @@ type Error struct {
 }
 
 type Data struct {
-	Viewer Viewer `json:"viewer"`
+	Viewer   Viewer            `json:"viewer"`
+	Issues   AssignedIssues    `json:"issues"`
+	Teams    TeamConnection    `json:"teams"`
+	Projects ProjectConnection `json:"projects"`
+	Cycles   CycleConnection   `json:"cycles"`
+	Issue    *Issue            `json:"issue"`
+	Schema   *Schema           `json:"__schema"`
+
+	// Mutation results
+	IssueBatchCreate *IssueBatchPayload `json:"issueBatchCreate"`
+	IssueLabels      Labels             `json:"issueLabels"`
+	IssueLabelCreate *IssueLabelPayload `json:"issueLabelCreate"`
+	IssueUpdate      *IssuePayload      `json:"issueUpdate"`
+}
+
+type ProjectConnection struct {
+	Nodes    []Project `json:"nodes"`
+	PageInfo PageInfo  `json:"pageInfo"`
+}
+
+type TeamConnection struct {
+	Nodes []Team `json:"nodes"`
 }
 
 type Viewer struct {
 	ID             string         `json:"id"`
 	Name           string         `json:"name"`
+	DisplayName    string         `json:"displayName"`
 	Email          string         `json:"email"`
 	AssignedIssues AssignedIssues `json:"assignedIssues"`
 }
@@ -51,22 +209,31 @@ type PageInfo struct {
 }
 
 type Issue struct {
-	ID          string   `json:"id"`
-	Identifier  string   `json:"identifier"`
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	URL         string   `json:"url"`
-	Priority    int      `json:"priority"`
-	Estimate    *float64 `json:"estimate"`
-	CreatedAt   string   `json:"createdAt"`
-	UpdatedAt   string   `json:"updatedAt"`
-	CompletedAt *string  `json:"completedAt"`
-	State       State    `json:"state"`
-	Team        Team     `json:"team"`
-	Project     *Project `json:"project"`
-	Cycle       *Cycle   `json:"cycle"`
-	Labels      Labels   `json:"labels"`
-	Assignee    User     `json:"assignee"`
+	ID           string         `json:"id"`
+	Identifier   string         `json:"identifier"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	URL          string         `json:"url"`
+	Priority     int            `json:"priority"`
+	Estimate     *float64       `json:"estimate"`
+	CreatedAt    string         `json:"createdAt"`
+	UpdatedAt    string         `json:"updatedAt"`
+	CompletedAt  *string        `json:"completedAt"`
+	DueDate      *string        `json:"dueDate"`
+	State        State          `json:"state"`
+	Team         Team           `json:"team"`
+	Project      *Project       `json:"project"`
+	Cycle        *Cycle         `json:"cycle"`
+	Labels       Labels         `json:"labels"`
+	Assignee     User           `json:"assignee"`
+	Subscribers  UserConnection `json:"subscribers"`
+	Relations    IssueRelations `json:"relations"`
+	CustomFields []CustomField  `json:"customFieldValues"`
+	History      IssueHistory   `json:"history"`
+	Comments     Comments       `json:"comments"`
+
+	// Workspace names the workspace the issue came from in --linear-key-file runs
+	Workspace string `json:"-"`
 }
 
 type State struct {
@@ -76,26 +243,89 @@ type State struct {
 }
 
 type Team struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Key  string `json:"key"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Key   string `json:"key"`
+	Color string `json:"color"`
 }
 
 type Project struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID                         string    `json:"id"`
+	Name                       string    `json:"name"`
+	State                      string    `json:"state"`
+	IssueCountHistory          []float64 `json:"issueCountHistory"`
+	CompletedIssueCountHistory []float64 `json:"completedIssueCountHistory"`
 }
 
 type Cycle struct {
-	Number int    `json:"number"`
-	Name   string `json:"name"`
+	Number   int    `json:"number"`
+	Name     string `json:"name"`
+	StartsAt string `json:"startsAt"`
+	EndsAt   string `json:"endsAt"`
+	Team     *Team  `json:"team"`
+
+	IssueCountHistory          []float64 `json:"issueCountHistory"`
+	CompletedIssueCountHistory []float64 `json:"completedIssueCountHistory"`
+	CompletedScopeHistory      []float64 `json:"completedScopeHistory"`
+	ScopeHistory               []float64 `json:"scopeHistory"`
+}
+
+type CycleConnection struct {
+	Nodes    []Cycle  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
 }
 
 type Labels struct {
-	Nodes []Label `json:"nodes"`
+	Nodes    []Label  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
 }
 
 type Label struct {
+	ID     string    `json:"id"`
+	Name   string    `json:"name"`
+	Parent *LabelRef `json:"parent"`
+	Team   *Team     `json:"team"`
+}
+
+type LabelRef struct {
+	Name string `json:"name"`
+}
+
+type IssueLabelPayload struct {
+	Success    bool  `json:"success"`
+	IssueLabel Label `json:"issueLabel"`
+}
+
+type IssuePayload struct {
+	Success bool `json:"success"`
+}
+
+type IssueBatchPayload struct {
+	Success bool    `json:"success"`
+	Issues  []Issue `json:"issues"`
+}
+
+type IssueHistory struct {
+	Nodes    []HistoryEvent `json:"nodes"`
+	PageInfo PageInfo       `json:"pageInfo"`
+}
+
+type HistoryEvent struct {
+	CreatedAt    string   `json:"createdAt"`
+	FromAssignee *User    `json:"fromAssignee"`
+	ToAssignee   *User    `json:"toAssignee"`
+	FromState    *State   `json:"fromState"`
+	ToState      *State   `json:"toState"`
+	FromEstimate *float64 `json:"fromEstimate"`
+	ToEstimate   *float64 `json:"toEstimate"`
+}
+
+type CustomField struct {
+	Definition CustomFieldDefinition `json:"definition"`
+	Value      string                `json:"value"`
+}
+
+type CustomFieldDefinition struct {
 	Name string `json:"name"`
 }
 
@@ -105,6 +335,32 @@ type User struct {
 	Email string `json:"email"`
 }
 
+type Comments struct {
+	Nodes []Comment `json:"nodes"`
+}
+
+type Comment struct {
+	CreatedAt string `json:"createdAt"`
+}
+
+type UserConnection struct {
+	Nodes []User `json:"nodes"`
+}
+
+type IssueRelations struct {
+	Nodes []IssueRelation `json:"nodes"`
+}
+
+type IssueRelation struct {
+	Type         string       `json:"type"`
+	RelatedIssue RelatedIssue `json:"relatedIssue"`
+}
+
+type RelatedIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+}
+
 // GraphQL Request
 type GraphQLRequest struct {
 	Query     string                 `json:"query"`
@@ -112,6 +368,13 @@ type GraphQLRequest struct {
 }
 
 // makeGraphQLRequest sends a GraphQL request to the Linear API
+// linearAPIURL and linearClient are used for every Linear request; parseFlags
+// replaces them for --linear-api-url and --linear-insecure
+var (
+	linearAPIURL = defaultLinearAPIURL
+	linearClient = &http.Client{Timeout: 30 * time.Second}
+)
+
 func makeGraphQLRequest(apiKey string, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	requestBody := GraphQLRequest{
 		Query:     query,
@@ -131,8 +394,7 @@ func makeGraphQLRequest(apiKey string, query string, variables map[string]interf
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", apiKey)
 
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := linearClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -159,397 +421,5854 @@ func makeGraphQLRequest(apiKey string, query string, variables map[string]interf
 	return &graphQLResp, nil
 }
 
-// getCompletedIssues fetches all completed issues assigned to the authenticated user
-func getCompletedIssues(apiKey string) ([]Issue, error) {
+// issueFilter builds the IssueFilter for the configured date range and teams
+func issueFilter(cfg *Config) map[string]interface{} {
+	filter := map[string]interface{}{
+		"completedAt": map[string]interface{}{"gte": cfg.PeriodStart, "lte": cfg.PeriodEnd},
+	}
+	if len(cfg.TeamKeys) > 0 {
+		filter["team"] = map[string]interface{}{
+			"key": map[string]interface{}{"in": []string(cfg.TeamKeys)},
+		}
+	}
+	return filter
+}
+
+// memberCompletions holds the issues one team member completed in the date range
+type memberCompletions struct {
+	Name   string
+	Issues int
+	Points float64
+}
+
+// getTeamBreakdown fetches the issues completed by anyone in the given teams
+// during the date range and totals them by assignee, most issues first
+func getTeamBreakdown(apiKey string, cfg *Config, teamKeys []string) ([]memberCompletions, error) {
 	query := `
-	query GetCompletedIssues($after: String, $startDate: DateTimeOrDuration!, $endDate: DateTimeOrDuration!) {
-		viewer {
-			id
-			name
-			email
-			assignedIssues(
-				first: 100
-				after: $after
-				includeArchived: true
-				filter: {
-					completedAt: { gte: $startDate, lte: $endDate }
-				}
-			) {
-				nodes {
-					id
-					identifier
-					title
-					description
-					url
-					priority
-					estimate
-					createdAt
-					updatedAt
-					completedAt
-					state {
-						id
-						name
-						type
-					}
-					team {
-						id
-						name
-						key
-					}
-					project {
-						id
-						name
-					}
-					cycle {
-						number
-						name
-					}
-					labels {
-						nodes {
-							name
-						}
-					}
-				}
-				pageInfo {
-					hasNextPage
-					endCursor
+	query GetTeamIssues($first: Int!, $after: String, $filter: IssueFilter!) {
+		issues(first: $first, after: $after, filter: $filter) {
+			nodes {
+				estimate
+				assignee {
+					name
 				}
 			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 		}
 	}
 	`
 
-	var allIssues []Issue
-	var afterCursor *string
-
-	fmt.Println("Fetching completed issues...")
+	filter := issueFilter(cfg)
+	filter["team"] = map[string]interface{}{
+		"key": map[string]interface{}{"in": teamKeys},
+	}
 
+	byName := make(map[string]*memberCompletions)
+	var afterCursor *string
 	for {
 		variables := map[string]interface{}{
-			"startDate": startDate,
-			"endDate":   endDate,
-			"after":     afterCursor,
+			"filter": filter,
+			"first":  cfg.pageSize(),
+			"after":  afterCursor,
 		}
-
 		resp, err := makeGraphQLRequest(apiKey, query, variables)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to fetch team issues: %w", err)
 		}
 
-		issues := resp.Data.Viewer.AssignedIssues.Nodes
-		allIssues = append(allIssues, issues...)
-
-		fmt.Printf("Fetched %d issues (total: %d)\n", len(issues), len(allIssues))
+		for _, issue := range resp.Data.Issues.Nodes {
+			name := issue.Assignee.Name
+			if name == "" {
+				name = "Unassigned"
+			}
+			member, ok := byName[name]
+			if !ok {
+				member = &memberCompletions{Name: name}
+				byName[name] = member
+			}
+			member.Issues++
+			if issue.Estimate != nil {
+				member.Points += *issue.Estimate
+			}
+		}
 
-		pageInfo := resp.Data.Viewer.AssignedIssues.PageInfo
-		if !pageInfo.HasNextPage {
+		pageInfo := resp.Data.Issues.PageInfo
+		if cfg.NoPagination || !pageInfo.HasNextPage {
 			break
 		}
 		afterCursor = pageInfo.EndCursor
 	}
 
-	// Filter for only completed state types
-	var doneIssues []Issue
-	for _, issue := range allIssues {
-		if issue.State.Type == "completed" {
-			doneIssues = append(doneIssues, issue)
-		}
+	members := make([]memberCompletions, 0, len(byName))
+	for _, member := range byName {
+		members = append(members, *member)
 	}
-
-	return doneIssues, nil
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Issues != members[j].Issues {
+			return members[i].Issues > members[j].Issues
+		}
+		return members[i].Name < members[j].Name
+	})
+	return members, nil
 }
 
-// formatPriority converts priority number to human-readable string
-func formatPriority(priority int) string {
-	priorityMap := map[int]string{
-		0: "No priority",
-		1: "Urgent",
-		2: "High",
-		3: "Medium",
-		4: "Low",
+// breakdownTeamKeys returns the --team keys, or else the teams of the viewer's issues
+func breakdownTeamKeys(issues []Issue, cfg *Config) []string {
+	if len(cfg.TeamKeys) > 0 {
+		return cfg.TeamKeys
 	}
-	if val, ok := priorityMap[priority]; ok {
-		return val
+	seen := make(map[string]bool)
+	var keys []string
+	for _, issue := range issues {
+		if !seen[issue.Team.Key] {
+			seen[issue.Team.Key] = true
+			keys = append(keys, issue.Team.Key)
+		}
 	}
-	return "Unknown"
+	sort.Strings(keys)
+	return keys
 }
 
-// formatDate formats ISO date string to readable format
-func formatDate(dateStr *string) string {
-	if dateStr == nil {
-		return "N/A"
+// printTeamBreakdown prints completed issues per team member as a bar chart
+func printTeamBreakdown(members []memberCompletions, cfg *Config) {
+	if len(members) == 0 {
+		return
 	}
-	t, err := time.Parse(time.RFC3339, *dateStr)
-	if err != nil {
-		return *dateStr
+	fmt.Println("\nTeam completions by member:")
+	maxIssues := members[0].Issues
+	for _, m := range members {
+		bar := ""
+		if !cfg.NoCharts {
+			bar = strings.Repeat("█", m.Issues*40/maxIssues) + " "
+		}
+		fmt.Printf("  %-25.25s %s%d issues, %.0f points\n", m.Name, bar, m.Issues, m.Points)
 	}
-	return t.Format("2006-01-02 15:04:05")
 }
 
-// formatDateString formats ISO date string to readable format (non-pointer version)
-func formatDateString(dateStr string) string {
-	t, err := time.Parse(time.RFC3339, dateStr)
+// exportTeamDistributionToCSV exports per-member completions to a CSV file
+func exportTeamDistributionToCSV(members []memberCompletions, filename string) error {
+	file, err := os.Create(filename)
 	if err != nil {
-		return dateStr
+		return fmt.Errorf("failed to create CSV file: %w", err)
 	}
-	return t.Format("2006-01-02 15:04:05")
-}
+	defer file.Close()
 
-// compactIssue is a flattened, minimal representation for JSON export
-type compactIssue struct {
-	Identifier  string   `json:"identifier"`
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	URL         string   `json:"url"`
-	Team        string   `json:"team"`
-	Priority    string   `json:"priority"`
-	Estimate    string   `json:"estimate,omitempty"`
-	Labels      []string `json:"labels,omitempty"`
-	Project     string   `json:"project,omitempty"`
-	Cycle       string   `json:"cycle,omitempty"`
-	CreatedAt   string   `json:"createdAt"`
-	CompletedAt string   `json:"completedAt"`
-}
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
 
-// exportToJSON exports issues to a compact JSON file
-func exportToJSON(issues []Issue, filename string) error {
-	compact := make([]compactIssue, len(issues))
-	for i, issue := range issues {
-		labels := make([]string, len(issue.Labels.Nodes))
-		for j, l := range issue.Labels.Nodes {
-			labels[j] = l.Name
+	if err := writer.Write([]string{"Assignee", "Issues", "Points"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, m := range members {
+		if err := writer.Write([]string{m.Name, strconv.Itoa(m.Issues), fmt.Sprintf("%.0f", m.Points)}); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
 		}
+	}
 
-		var project, cycle, estimate string
-		if issue.Project != nil {
-			project = issue.Project.Name
-		}
-		if issue.Cycle != nil {
-			cycle = issue.Cycle.Name
-		}
-		if issue.Estimate != nil {
-			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
-		}
+	fmt.Printf("✅ Exported completions for %d team members to %s\n", len(members), filename)
+	return nil
+}
 
-		compact[i] = compactIssue{
-			Identifier:  issue.Identifier,
-			Title:       issue.Title,
-			Description: issue.Description,
-			URL:         issue.URL,
-			Team:        issue.Team.Name,
-			Priority:    formatPriority(issue.Priority),
-			Estimate:    estimate,
-			Labels:      labels,
-			Project:     project,
-			Cycle:       cycle,
-			CreatedAt:   formatDateString(issue.CreatedAt),
-			CompletedAt: formatDate(issue.CompletedAt),
+// getTeams fetches all teams in the workspace
+func getTeams(apiKey string) ([]Team, error) {
+	query := `
+	query GetTeams {
+		teams(first: 250) {
+			nodes {
+				id
+				name
+				key
+				color
+			}
 		}
 	}
+	`
 
-	data, err := json.MarshalIndent(compact, "", "  ")
+	resp, err := makeGraphQLRequest(apiKey, query, nil)
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+		return nil, fmt.Errorf("failed to fetch teams: %w", err)
 	}
+	return resp.Data.Teams.Nodes, nil
+}
 
-	if err := os.WriteFile(filename, data, 0644); err != nil {
-		return fmt.Errorf("failed to write JSON file: %w", err)
+// validateTeams checks every --team value against the workspace team keys
+// (case-insensitively), caches the team list in cfg and normalizes the keys.
+func validateTeams(apiKey string, cfg *Config) error {
+	teams, err := getTeams(apiKey)
+	if err != nil {
+		return err
 	}
+	cfg.Teams = teams
 
-	fmt.Printf("\n✅ Exported %d issues to %s\n", len(issues), filename)
+	byKey := make(map[string]string, len(teams))
+	for _, team := range teams {
+		byKey[strings.ToUpper(team.Key)] = team.Key
+	}
+
+	var unknown []string
+	for i, key := range cfg.TeamKeys {
+		canonical, ok := byKey[strings.ToUpper(key)]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		cfg.TeamKeys[i] = canonical
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown team key(s): %s", strings.Join(unknown, ", "))
+	}
 	return nil
 }
 
-// exportToCSV exports issues to CSV file
-func exportToCSV(issues []Issue, filename string) error {
-	if len(issues) == 0 {
-		fmt.Println("No issues to export")
-		return nil
+// printAvailableTeams lists the workspace teams cached in cfg
+func printAvailableTeams(teams []Team) {
+	fmt.Println("\nAvailable teams:")
+	for _, team := range teams {
+		fmt.Printf("  %-10s %s\n", team.Key, team.Name)
 	}
+}
 
-	file, err := os.Create(filename)
+// readIssuesJSON reads the issues from a JSON export, with or without the
+// previousPeriod wrapper added by --compare-previous-period
+func readIssuesJSON(filename string) ([]compactIssue, error) {
+	data, err := os.ReadFile(filename)
 	if err != nil {
-		return fmt.Errorf("failed to create CSV file: %w", err)
+		return nil, fmt.Errorf("failed to read %s: %w", filename, err)
 	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
-	// Write header
-	header := []string{
-		"Identifier", "Title", "URL", "Team", "State", "Priority",
-		"Estimate", "Labels", "Project", "Cycle", "Created At",
-		"Completed At", "Assignee",
+	var issues []compactIssue
+	if err := json.Unmarshal(data, &issues); err == nil {
+		return issues, nil
 	}
-	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+	var wrapped struct {
+		Issues []compactIssue `json:"issues"`
 	}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	return wrapped.Issues, nil
+}
 
-	// Write rows
-	for _, issue := range issues {
-		labels := []string{}
+// diffLine is one line of a line diff: ' ' for unchanged, '-' removed, '+' added
+type diffLine struct {
+	Op   byte
+	Text string
+}
+
+// diffLines computes a line diff of a and b from their longest common subsequence
+func diffLines(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
+
+// unifiedDiff renders a line diff as unified diff hunks with three lines of context
+func unifiedDiff(oldName, newName string, lines []diffLine) string {
+	const context = 3
+
+	// oldLine[k] and newLine[k] are the 1-based line numbers at lines[k]
+	oldLine := make([]int, len(lines)+1)
+	newLine := make([]int, len(lines)+1)
+	oldLine[0], newLine[0] = 1, 1
+	for k, l := range lines {
+		oldLine[k+1], newLine[k+1] = oldLine[k], newLine[k]
+		if l.Op != '+' {
+			oldLine[k+1]++
+		}
+		if l.Op != '-' {
+			newLine[k+1]++
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for k := 0; k < len(lines); {
+		for k < len(lines) && lines[k].Op == ' ' {
+			k++
+		}
+		if k == len(lines) {
+			break
+		}
+
+		// Extend the hunk over changes separated by at most 2*context unchanged lines
+		end := k
+		for end < len(lines) {
+			if lines[end].Op != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(lines) && lines[run].Op == ' ' {
+				run++
+			}
+			if run == len(lines) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+
+		start := max(0, k-context)
+		stop := min(len(lines), end+context)
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", oldLine[start], oldLine[stop]-oldLine[start],
+			newLine[start], newLine[stop]-newLine[start])
+		for _, l := range lines[start:stop] {
+			fmt.Fprintf(&b, "%c%s\n", l.Op, l.Text)
+		}
+		k = stop
+	}
+	return b.String()
+}
+
+// exportPatterns match the JSON and CSV exports of both extractors
+var exportPatterns = []string{"linear_*.json", "linear_*.csv", "pull_requests_*.json", "pull_requests_*.csv"}
+
+// runClean deletes export files in a directory that were last modified before
+// the retention window
+func runClean(args []string) {
+	fs := flag.NewFlagSet("clean", flag.ExitOnError)
+	olderThan := fs.String("older-than", "30d", "delete exports last modified longer ago than this (days with a d suffix, or a Go duration)")
+	dir := fs.String("dir", ".", "directory containing the exports")
+	dryRun := fs.Bool("dry-run", false, "list the files that would be deleted without deleting them")
+	fs.Parse(args)
+
+	age, err := parseDayDuration(*olderThan)
+	if err != nil || age <= 0 {
+		fmt.Printf("❌ Error: invalid --older-than %q\n", *olderThan)
+		os.Exit(1)
+	}
+	cutoff := time.Now().Add(-age)
+
+	var matches []string
+	for _, pattern := range exportPatterns {
+		found, err := filepath.Glob(filepath.Join(*dir, pattern))
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			os.Exit(1)
+		}
+		matches = append(matches, found...)
+	}
+	sort.Strings(matches)
+
+	deleted := 0
+	for _, path := range matches {
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() || !info.ModTime().Before(cutoff) {
+			continue
+		}
+		if *dryRun {
+			fmt.Printf("  would delete %s (modified %s)\n", path, info.ModTime().Format("2006-01-02"))
+			deleted++
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			fmt.Printf("❌ Error deleting %s: %v\n", path, err)
+			continue
+		}
+		fmt.Printf("  deleted %s (modified %s)\n", path, info.ModTime().Format("2006-01-02"))
+		deleted++
+	}
+
+	if *dryRun {
+		fmt.Printf("\n%d of %d exports in %s would be deleted\n", deleted, len(matches), *dir)
+		return
+	}
+	fmt.Printf("\n✅ Deleted %d of %d exports in %s\n", deleted, len(matches), *dir)
+}
+
+// webhookMaxAge is how old a webhook delivery's timestamp may be before it is
+// rejected as a possible replay
+const webhookMaxAge = time.Minute
+
+// webhookPayload is the body of a Linear data change webhook
+type webhookPayload struct {
+	Action           string                 `json:"action"`
+	Type             string                 `json:"type"`
+	Data             webhookIssue           `json:"data"`
+	UpdatedFrom      map[string]interface{} `json:"updatedFrom"`
+	WebhookTimestamp int64                  `json:"webhookTimestamp"`
+}
+
+// webhookIssue is the issue in a webhook payload. Unlike the GraphQL API,
+// webhooks send labels as a plain list.
+type webhookIssue struct {
+	Identifier  string   `json:"identifier"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Priority    int      `json:"priority"`
+	Estimate    *float64 `json:"estimate"`
+	CreatedAt   string   `json:"createdAt"`
+	CompletedAt *string  `json:"completedAt"`
+	DueDate     *string  `json:"dueDate"`
+	State       State    `json:"state"`
+	Team        Team     `json:"team"`
+	Project     *Project `json:"project"`
+	Cycle       *Cycle   `json:"cycle"`
+	Labels      []Label  `json:"labels"`
+	Assignee    User     `json:"assignee"`
+}
+
+// issue converts the webhook issue into the shape returned by the GraphQL API
+func (w webhookIssue) issue() Issue {
+	return Issue{
+		Identifier:  w.Identifier,
+		Title:       w.Title,
+		Description: w.Description,
+		URL:         w.URL,
+		Priority:    w.Priority,
+		Estimate:    w.Estimate,
+		CreatedAt:   w.CreatedAt,
+		CompletedAt: w.CompletedAt,
+		DueDate:     w.DueDate,
+		State:       w.State,
+		Team:        w.Team,
+		Project:     w.Project,
+		Cycle:       w.Cycle,
+		Labels:      Labels{Nodes: w.Labels},
+		Assignee:    w.Assignee,
+	}
+}
+
+// validWebhookSignature reports whether signature is the hex HMAC-SHA256 of body
+// under secret, as sent in the Linear-Signature header
+func validWebhookSignature(body []byte, signature, secret string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// webhookHandler appends each issue that moves into a completed state to a
+// JSON Lines file of compact issues
+type webhookHandler struct {
+	secret string
+	out    string
+	cfg    *Config
+	mu     sync.Mutex
+}
+
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if !validWebhookSignature(body, r.Header.Get("Linear-Signature"), h.secret) {
+		fmt.Printf("⚠️  Rejected webhook with an invalid signature from %s\n", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if age := time.Since(time.UnixMilli(payload.WebhookTimestamp)); age > webhookMaxAge || age < -webhookMaxAge {
+		http.Error(w, "stale webhook", http.StatusUnauthorized)
+		return
+	}
+
+	// Only state changes into a completed state count; later edits to a
+	// completed issue arrive as updates too but without a previous stateId
+	_, stateChanged := payload.UpdatedFrom["stateId"]
+	if payload.Type != "Issue" || payload.Action != "update" || !stateChanged || payload.Data.State.Type != "completed" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.appendIssue(payload.Data.issue()); err != nil {
+		fmt.Printf("❌ Error appending %s: %v\n", payload.Data.Identifier, err)
+		http.Error(w, "failed to record issue", http.StatusInternalServerError)
+		return
+	}
+	fmt.Printf("✅ %s completed: %s\n", payload.Data.Identifier, payload.Data.Title)
+	w.WriteHeader(http.StatusOK)
+}
+
+// appendIssue writes the issue as one compact JSON line to the output file
+func (h *webhookHandler) appendIssue(issue Issue) error {
+	line, err := json.Marshal(toCompactIssues([]Issue{issue}, h.cfg)[0])
+	if err != nil {
+		return fmt.Errorf("failed to marshal issue: %w", err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	file, err := os.OpenFile(h.out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", h.out, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s: %w", h.out, err)
+	}
+	return nil
+}
+
+// runWebhookServer listens for Linear webhooks and records completed issues as they happen
+func runWebhookServer(args []string) {
+	fs := flag.NewFlagSet("webhook-server", flag.ExitOnError)
+	port := fs.Int("port", 8080, "port to listen on")
+	out := fs.String("out", "webhook_completed_issues.jsonl", "JSON Lines file that completed issues are appended to")
+	fs.Parse(args)
+
+	secret := os.Getenv("LINEAR_WEBHOOK_SECRET")
+	if secret == "" {
+		fmt.Println("❌ Error: LINEAR_WEBHOOK_SECRET environment variable not set!")
+		fmt.Println("   Copy the signing secret from the webhook's settings in Linear.")
+		os.Exit(1)
+	}
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", *port),
+		Handler:           &webhookHandler{secret: secret, out: *out, cfg: &Config{ImpactWeights: defaultImpactWeights}},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	fmt.Printf("👂 Listening for Linear webhooks on %s, appending completed issues to %s\n", server.Addr, *out)
+	if err := server.ListenAndServe(); err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runDiffDescriptions prints a unified diff of the description of every issue
+// present in two JSON exports whose description changed between them
+func runDiffDescriptions(args []string) {
+	fs := flag.NewFlagSet("diff-descriptions", flag.ExitOnError)
+	markdown := fs.Bool("markdown", false, "also write the diffs to description_diffs.md")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fmt.Println("❌ Error: usage: diff-descriptions [--markdown] OLD_JSON NEW_JSON")
+		os.Exit(1)
+	}
+	oldFile, newFile := fs.Arg(0), fs.Arg(1)
+
+	oldIssues, err := readIssuesJSON(oldFile)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	newIssues, err := readIssuesJSON(newFile)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	issueKey := func(issue compactIssue) string {
+		return issue.Workspace + "/" + issue.Identifier
+	}
+	oldByKey := make(map[string]compactIssue, len(oldIssues))
+	for _, issue := range oldIssues {
+		oldByKey[issueKey(issue)] = issue
+	}
+
+	var md strings.Builder
+	changed, common := 0, 0
+	for _, issue := range newIssues {
+		old, ok := oldByKey[issueKey(issue)]
+		if !ok {
+			continue
+		}
+		common++
+		if old.Description == issue.Description {
+			continue
+		}
+		changed++
+
+		diff := unifiedDiff(oldFile, newFile, diffLines(strings.Split(old.Description, "\n"), strings.Split(issue.Description, "\n")))
+		fmt.Printf("\n%s: %s\n%s", issue.Identifier, issue.Title, diff)
+		fmt.Fprintf(&md, "## %s: %s\n\n```diff\n%s```\n\n", issue.Identifier, issue.Title, diff)
+	}
+
+	fmt.Printf("\n%d of %d issues present in both files have changed descriptions\n", changed, common)
+
+	if *markdown && changed > 0 {
+		content := "# Description changes\n\n" + md.String()
+		if err := os.WriteFile("description_diffs.md", []byte(content), 0644); err != nil {
+			fmt.Printf("❌ Error exporting description diffs: %v\n", err)
+			return
+		}
+		fmt.Printf("✅ Exported %d description diffs to description_diffs.md\n", changed)
+	}
+}
+
+// runTeams lists the workspace teams, for use with --team
+func runTeams(apiKey string) {
+	teams, err := getTeams(apiKey)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%-10s %-30s %s\n", "Key", "Name", "ID")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, team := range teams {
+		fmt.Printf("%-10s %-30s %s\n", team.Key, team.Name, team.ID)
+	}
+}
+
+// runProjects lists the workspace projects
+func runProjects(apiKey string) {
+	query := `
+	query GetProjects($first: Int!, $after: String) {
+		projects(first: $first, after: $after) {
+			nodes {
+				id
+				name
+				state
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+
+	var projects []Project
+	var afterCursor *string
+	for {
+		variables := map[string]interface{}{
+			"first": defaultPageSize,
+			"after": afterCursor,
+		}
+		resp, err := makeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			fmt.Printf("❌ Error: failed to fetch projects: %v\n", err)
+			os.Exit(1)
+		}
+		projects = append(projects, resp.Data.Projects.Nodes...)
+
+		pageInfo := resp.Data.Projects.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+	fmt.Printf("%-40s %-12s %s\n", "Name", "State", "ID")
+	fmt.Println(strings.Repeat("-", 92))
+	for _, project := range projects {
+		fmt.Printf("%-40.40s %-12s %s\n", project.Name, project.State, project.ID)
+	}
+}
+
+// cycleReportRow is one cycle in the cycles report
+type cycleReportRow struct {
+	Team      string
+	Cycle     Cycle
+	Total     int
+	Completed int
+	Velocity  int
+}
+
+// completionRate returns the percentage of the cycle's issues that were completed
+func (r cycleReportRow) completionRate() float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(r.Completed) / float64(r.Total) * 100
+}
+
+// cyclesQuery fetches a page of cycles with their issue count and scope history
+const cyclesQuery = `
+query GetCycles($first: Int!, $after: String, $filter: CycleFilter) {
+	cycles(first: $first, after: $after, filter: $filter) {
+		nodes {
+			number
+			name
+			startsAt
+			endsAt
+			issueCountHistory
+			completedIssueCountHistory
+			completedScopeHistory
+			scopeHistory
+			team {
+				key
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+// getCycles fetches the cycles matching filter, or every team's cycles when
+// filter is nil, following pagination
+func getCycles(apiKey string, filter map[string]interface{}) ([]Cycle, error) {
+	var cycles []Cycle
+	var afterCursor *string
+	for {
+		variables := map[string]interface{}{
+			"first":  defaultPageSize,
+			"after":  afterCursor,
+			"filter": filter,
+		}
+		resp, err := makeGraphQLRequest(apiKey, cyclesQuery, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch cycles: %w", err)
+		}
+		cycles = append(cycles, resp.Data.Cycles.Nodes...)
+
+		pageInfo := resp.Data.Cycles.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+	return cycles, nil
+}
+
+// runCycles lists the cycles of every team with their issue totals, completion
+// rate and velocity (completed points), and exports them to cycles_report.csv
+func runCycles(apiKey string) {
+	cycles, err := getCycles(apiKey, nil)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	rows := make([]cycleReportRow, len(cycles))
+	for i, c := range cycles {
+		rows[i] = cycleReportRow{
+			Cycle:     c,
+			Total:     lastValue(c.IssueCountHistory),
+			Completed: lastValue(c.CompletedIssueCountHistory),
+			Velocity:  lastValue(c.CompletedScopeHistory),
+		}
+		if c.Team != nil {
+			rows[i].Team = c.Team.Key
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Team != rows[j].Team {
+			return rows[i].Team < rows[j].Team
+		}
+		return rows[i].Cycle.Number > rows[j].Cycle.Number
+	})
+
+	fmt.Printf("%-10s %-7s %-30s %-18s %-18s %7s %9s %6s %8s\n",
+		"Team", "Cycle", "Name", "Starts", "Ends", "Issues", "Completed", "Rate", "Velocity")
+	fmt.Println(strings.Repeat("-", 122))
+	for _, r := range rows {
+		fmt.Printf("%-10s %-7d %-30.30s %-18s %-18s %7d %9d %5.0f%% %8d\n", r.Team, r.Cycle.Number, r.Cycle.Name,
+			formatDateString(r.Cycle.StartsAt), formatDateString(r.Cycle.EndsAt), r.Total, r.Completed, r.completionRate(), r.Velocity)
+	}
+
+	fmt.Println("\nVelocity trend (oldest to newest):")
+	for start := 0; start < len(rows); {
+		end := start
+		var velocities []int
+		for end < len(rows) && rows[end].Team == rows[start].Team {
+			velocities = append([]int{rows[end].Velocity}, velocities...)
+			end++
+		}
+		fmt.Printf("  %-10s %s\n", rows[start].Team, sparkline(velocities))
+		start = end
+	}
+
+	if err := exportCyclesReportToCSV(rows, "cycles_report.csv"); err != nil {
+		fmt.Printf("❌ Error exporting cycles report CSV: %v\n", err)
+	}
+}
+
+// cycleRangeFilter limits the cycle fetch to the issues' teams and to cycles
+// that overlap the span from the earliest issue's creation to the period end
+func cycleRangeFilter(issues []Issue, cfg *Config) map[string]interface{} {
+	earliest := cfg.PeriodStart
+	for _, issue := range issues {
+		if issue.CreatedAt < earliest {
+			earliest = issue.CreatedAt
+		}
+	}
+	return map[string]interface{}{
+		"team": map[string]interface{}{
+			"key": map[string]interface{}{"in": breakdownTeamKeys(issues, cfg)},
+		},
+		"endsAt":   map[string]interface{}{"gte": earliest},
+		"startsAt": map[string]interface{}{"lte": cfg.PeriodEnd},
+	}
+}
+
+// cycleAt returns the team's cycle that was running at timestamp, or nil
+func cycleAt(cycles []Cycle, teamKey, timestamp string) *Cycle {
+	at, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return nil
+	}
+	for i, c := range cycles {
+		if c.Team == nil || c.Team.Key != teamKey {
+			continue
+		}
+		starts, err := time.Parse(time.RFC3339, c.StartsAt)
+		if err != nil {
+			continue
+		}
+		ends, err := time.Parse(time.RFC3339, c.EndsAt)
+		if err != nil {
+			continue
+		}
+		if !at.Before(starts) && at.Before(ends) {
+			return &cycles[i]
+		}
+	}
+	return nil
+}
+
+// spannedCycles reports whether the issue was completed in a later cycle than
+// the one running when it was created. Issues created or completed outside any
+// cycle don't count.
+func spannedCycles(issue Issue, cycles []Cycle) bool {
+	if issue.CompletedAt == nil {
+		return false
+	}
+	created := cycleAt(cycles, issue.Team.Key, issue.CreatedAt)
+	completed := cycleAt(cycles, issue.Team.Key, *issue.CompletedAt)
+	return created != nil && completed != nil && created.Number != completed.Number
+}
+
+// cycleOverruns returns the issues that spanned more than one cycle
+func cycleOverruns(issues []Issue, cycles []Cycle) []Issue {
+	var overruns []Issue
+	for _, issue := range issues {
+		if spannedCycles(issue, cycles) {
+			overruns = append(overruns, issue)
+		}
+	}
+	return overruns
+}
+
+// exportCycleOverrunsToCSV exports issues that spanned cycles with the cycle they
+// were created in and the one they were completed in
+func exportCycleOverrunsToCSV(issues []Issue, cycles []Cycle, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Identifier", "Title", "Team", "Estimate", "Created Cycle", "Completed Cycle"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, issue := range issues {
+		created := cycleAt(cycles, issue.Team.Key, issue.CreatedAt)
+		completed := cycleAt(cycles, issue.Team.Key, *issue.CompletedAt)
+		estimate := ""
+		if issue.Estimate != nil {
+			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+		}
+		row := []string{issue.Identifier, issue.Title, issue.Team.Key, estimate,
+			strconv.Itoa(created.Number), strconv.Itoa(completed.Number)}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d issues that spanned cycles to %s\n", len(issues), filename)
+	return nil
+}
+
+// cycleFitFlagPct is the share of its cycle's capacity above which an issue is flagged
+const cycleFitFlagPct = 20
+
+// cycleCapacity returns the total scope in points of the issue's cycle, or 0
+// when the issue is not in a cycle
+func cycleCapacity(issue Issue, cycles []Cycle) int {
+	if issue.Cycle == nil {
+		return 0
+	}
+	for _, c := range cycles {
+		if c.Team != nil && c.Team.Key == issue.Team.Key && c.Number == issue.Cycle.Number {
+			return lastValue(c.ScopeHistory)
+		}
+	}
+	return 0
+}
+
+// cycleCapacityPct returns the issue's estimate as a percentage of its cycle's
+// capacity, or nil when the issue is unestimated or its cycle has no scope
+func cycleCapacityPct(issue Issue, cycles []Cycle) *float64 {
+	capacity := cycleCapacity(issue, cycles)
+	if issue.Estimate == nil || capacity == 0 {
+		return nil
+	}
+	pct := *issue.Estimate / float64(capacity) * 100
+	return &pct
+}
+
+// cycleFitRow is one issue's share of the capacity of the cycle it was completed in
+type cycleFitRow struct {
+	Issue    Issue
+	Cycle    string
+	Capacity int
+	Pct      float64
+}
+
+// computeCycleFit returns the capacity share of every estimated issue in a cycle,
+// grouped by team and cycle with the largest shares first
+func computeCycleFit(issues []Issue, cycles []Cycle) []cycleFitRow {
+	var rows []cycleFitRow
+	for _, issue := range issues {
+		pct := cycleCapacityPct(issue, cycles)
+		if pct == nil {
+			continue
+		}
+		rows = append(rows, cycleFitRow{
+			Issue:    issue,
+			Cycle:    fmt.Sprintf("%s #%d", issue.Team.Key, issue.Cycle.Number),
+			Capacity: cycleCapacity(issue, cycles),
+			Pct:      *pct,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Issue.Team.Key != rows[j].Issue.Team.Key {
+			return rows[i].Issue.Team.Key < rows[j].Issue.Team.Key
+		}
+		if rows[i].Issue.Cycle.Number != rows[j].Issue.Cycle.Number {
+			return rows[i].Issue.Cycle.Number < rows[j].Issue.Cycle.Number
+		}
+		return rows[i].Pct > rows[j].Pct
+	})
+	return rows
+}
+
+// printCycleFit prints the three issues that took the largest share of each cycle
+func printCycleFit(rows []cycleFitRow) {
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Printf("\nLargest issues per cycle (flagged above %d%% of capacity):\n", cycleFitFlagPct)
+	for start := 0; start < len(rows); {
+		end := start
+		for end < len(rows) && rows[end].Cycle == rows[start].Cycle {
+			end++
+		}
+		fmt.Printf("  %s (%d points):\n", rows[start].Cycle, rows[start].Capacity)
+		for i := start; i < end && i < start+3; i++ {
+			marker := ""
+			if rows[i].Pct > cycleFitFlagPct {
+				marker = " ⚠️"
+			}
+			fmt.Printf("    %s %5.1f%%%s: %s\n", rows[i].Issue.Identifier, rows[i].Pct, marker, rows[i].Issue.Title)
+		}
+		start = end
+	}
+}
+
+// exportCycleFitToCSV exports each issue's share of its cycle's capacity to a CSV file
+func exportCycleFitToCSV(rows []cycleFitRow, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Identifier", "Title", "Cycle", "Estimate", "Cycle Capacity", "Capacity %", "Flagged"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, r := range rows {
+		row := []string{
+			r.Issue.Identifier,
+			r.Issue.Title,
+			r.Cycle,
+			fmt.Sprintf("%.0f", *r.Issue.Estimate),
+			strconv.Itoa(r.Capacity),
+			fmt.Sprintf("%.1f", r.Pct),
+			strconv.FormatBool(r.Pct > cycleFitFlagPct),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported cycle fit for %d issues to %s\n", len(rows), filename)
+	return nil
+}
+
+// exportCyclesReportToCSV exports the cycles report to a CSV file
+func exportCyclesReportToCSV(rows []cycleReportRow, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"team", "cycle", "start", "end", "total_issues", "completed", "completion_rate_pct", "velocity"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, r := range rows {
+		row := []string{
+			r.Team,
+			strconv.Itoa(r.Cycle.Number),
+			formatDateString(r.Cycle.StartsAt),
+			formatDateString(r.Cycle.EndsAt),
+			strconv.Itoa(r.Total),
+			strconv.Itoa(r.Completed),
+			fmt.Sprintf("%.1f", r.completionRate()),
+			strconv.Itoa(r.Velocity),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d cycles to %s\n", len(rows), filename)
+	return nil
+}
+
+// Schema is the subset of GraphQL introspection needed to check field paths
+type Schema struct {
+	QueryType struct {
+		Name string `json:"name"`
+	} `json:"queryType"`
+	Types []SchemaType `json:"types"`
+}
+
+type SchemaType struct {
+	Name   string        `json:"name"`
+	Fields []SchemaField `json:"fields"`
+}
+
+type SchemaField struct {
+	Name string  `json:"name"`
+	Type TypeRef `json:"type"`
+}
+
+type TypeRef struct {
+	Name   string   `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// namedType unwraps NON_NULL and LIST wrappers to the underlying type name
+func (t TypeRef) namedType() string {
+	for t.Name == "" && t.OfType != nil {
+		t = *t.OfType
+	}
+	return t.Name
+}
+
+const introspectionQuery = `
+query Introspection {
+	__schema {
+		queryType {
+			name
+		}
+		types {
+			name
+			fields(includeDeprecated: true) {
+				name
+				type {
+					name
+					ofType {
+						name
+						ofType {
+							name
+							ofType {
+								name
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+// schemaCachePath returns the cache file for the schema of the configured endpoint
+func schemaCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New32a()
+	h.Write([]byte(linearAPIURL))
+	return filepath.Join(dir, "linear-extractor", fmt.Sprintf("schema-%08x.json", h.Sum32())), nil
+}
+
+// getSchema returns the introspected schema, reusing the on-disk copy while it
+// is younger than schemaCacheTTL
+func getSchema(apiKey string) (*Schema, error) {
+	path, pathErr := schemaCachePath()
+	if pathErr == nil {
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < schemaCacheTTL {
+			if data, err := os.ReadFile(path); err == nil {
+				var schema Schema
+				if err := json.Unmarshal(data, &schema); err == nil {
+					return &schema, nil
+				}
+			}
+		}
+	}
+
+	resp, err := makeGraphQLRequest(apiKey, introspectionQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect schema: %w", err)
+	}
+	if resp.Data.Schema == nil {
+		return nil, fmt.Errorf("introspection returned no schema")
+	}
+
+	if pathErr == nil {
+		if data, err := json.Marshal(resp.Data.Schema); err == nil {
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+				os.WriteFile(path, data, 0644)
+			}
+		}
+	}
+	return resp.Data.Schema, nil
+}
+
+// graphQLTokens splits a GraphQL document into names and punctuation, dropping
+// whitespace, commas, comments and literal values
+func graphQLTokens(doc string) []string {
+	isName := func(c byte) bool {
+		return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	}
+
+	var tokens []string
+	for i := 0; i < len(doc); {
+		c := doc[i]
+		switch {
+		case c == '#':
+			for i < len(doc) && doc[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			for i++; i < len(doc) && doc[i] != '"'; i++ {
+				if doc[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		case strings.HasPrefix(doc[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case isName(c):
+			start := i
+			for i < len(doc) && (isName(doc[i]) || (doc[i] >= '0' && doc[i] <= '9')) {
+				i++
+			}
+			tokens = append(tokens, doc[start:i])
+		case strings.IndexByte("{}():@", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			i++
+		}
+	}
+	return tokens
+}
+
+// schemaValidator walks the selection sets of a query, resolving each field
+// against the schema and recording the paths that do not exist
+type schemaValidator struct {
+	fields  map[string]map[string]string
+	tokens  []string
+	pos     int
+	missing []string
+}
+
+func (v *schemaValidator) peek() string {
+	if v.pos < len(v.tokens) {
+		return v.tokens[v.pos]
+	}
+	return ""
+}
+
+func (v *schemaValidator) next() string {
+	token := v.peek()
+	v.pos++
+	return token
+}
+
+// skipBalanced skips from an opening token to its matching close
+func (v *schemaValidator) skipBalanced(open, close string) {
+	depth := 0
+	for v.pos < len(v.tokens) {
+		switch v.next() {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (v *schemaValidator) skipDirectives() {
+	for v.peek() == "@" {
+		v.next()
+		v.next()
+		if v.peek() == "(" {
+			v.skipBalanced("(", ")")
+		}
+	}
+}
+
+// selectionSet checks the fields of the selection set starting at the current
+// "{" against typeName
+func (v *schemaValidator) selectionSet(typeName, path string) {
+	v.next()
+	for v.pos < len(v.tokens) && v.peek() != "}" {
+		if v.peek() == "..." {
+			v.next()
+			fragmentType := typeName
+			if v.peek() == "on" {
+				v.next()
+				fragmentType = v.next()
+			}
+			v.skipDirectives()
+			if v.peek() == "{" {
+				v.selectionSet(fragmentType, path)
+			}
+			continue
+		}
+
+		name := v.next()
+		if v.peek() == ":" {
+			v.next()
+			name = v.next()
+		}
+		if v.peek() == "(" {
+			v.skipBalanced("(", ")")
+		}
+		v.skipDirectives()
+
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		fieldType, ok := v.fields[typeName][name]
+		if !ok && name != "__typename" {
+			v.missing = append(v.missing, fmt.Sprintf("%s: %s has no field %q", fieldPath, typeName, name))
+		}
+		if v.peek() == "{" {
+			if ok {
+				v.selectionSet(fieldType, fieldPath)
+			} else {
+				v.skipBalanced("{", "}")
+			}
+		}
+	}
+	v.next()
+}
+
+// validateQuery returns a description of every field in query that is missing
+// from the schema
+func validateQuery(schema *Schema, query string) []string {
+	fields := make(map[string]map[string]string, len(schema.Types))
+	for _, t := range schema.Types {
+		fields[t.Name] = make(map[string]string, len(t.Fields))
+		for _, f := range t.Fields {
+			fields[t.Name][f.Name] = f.Type.namedType()
+		}
+	}
+
+	v := &schemaValidator{fields: fields, tokens: graphQLTokens(query)}
+	for v.pos < len(v.tokens) && v.peek() != "{" {
+		if v.next() == "(" {
+			v.pos--
+			v.skipBalanced("(", ")")
+		}
+	}
+	if v.peek() == "{" {
+		v.selectionSet(schema.QueryType.Name, "")
+	}
+	return v.missing
+}
+
+// completedIssuesQuery fetches a page of completed issues assigned to the viewer
+const completedIssuesQuery = `
+query GetCompletedIssues($first: Int!, $after: String, $filter: IssueFilter!) {
+	viewer {
+		id
+		name
+		email
+		assignedIssues(
+			first: $first
+			after: $after
+			includeArchived: true
+			filter: $filter
+		) {
+			nodes {
+				id
+				identifier
+				title
+				description
+				url
+				priority
+				estimate
+				createdAt
+				updatedAt
+				completedAt
+				dueDate
+				state {
+					id
+					name
+					type
+				}
+				team {
+					id
+					name
+					key
+					color
+				}
+				project {
+					id
+					name
+				}
+				cycle {
+					number
+					name
+				}
+				labels {
+					nodes {
+						id
+						name
+						parent {
+							name
+						}
+					}
+				}
+				assignee {
+					id
+					name
+					email
+				}
+				subscribers(first: 100) {
+					nodes {
+						id
+						name
+						email
+					}
+				}
+				relations(first: 50) {
+					nodes {
+						type
+						relatedIssue {
+							id
+							identifier
+						}
+					}
+				}
+				comments(first: 100) {
+					nodes {
+						createdAt
+					}
+				}
+				history(first: 50) {
+					nodes {
+						createdAt
+						fromAssignee {
+							id
+							name
+						}
+						toAssignee {
+							id
+							name
+						}
+						fromState {
+							name
+							type
+						}
+						toState {
+							name
+							type
+						}
+						fromEstimate
+						toEstimate
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+// customFieldValuesSelection adds custom field values to completedIssuesQuery.
+// Only some workspaces have custom fields, so it is requested with --custom-fields only.
+const customFieldValuesSelection = `
+				customFieldValues {
+					definition {
+						name
+					}
+					value
+				}
+`
+
+// issuesQuery returns completedIssuesQuery, with custom field values selected
+// when --custom-fields is set
+func issuesQuery(cfg *Config) string {
+	if !cfg.CustomFields {
+		return completedIssuesQuery
+	}
+	anchor := "\t\t\t\tcomments(first: 100) {"
+	return strings.Replace(completedIssuesQuery, anchor, strings.TrimPrefix(customFieldValuesSelection, "\n")+anchor, 1)
+}
+
+// getCompletedIssues fetches all completed issues assigned to the authenticated user.
+// In preview mode, or with pagination disabled, only the first page is fetched.
+func getCompletedIssues(apiKey string, cfg *Config) ([]Issue, error) {
+
+	var allIssues []Issue
+	var afterCursor *string
+
+	fmt.Println("Fetching completed issues...")
+
+	for {
+		variables := map[string]interface{}{
+			"filter": issueFilter(cfg),
+			"first":  cfg.pageSize(),
+			"after":  afterCursor,
+		}
+
+		resp, err := makeGraphQLRequest(apiKey, issuesQuery(cfg), variables)
+		if err != nil {
+			return nil, err
+		}
+
+		issues := resp.Data.Viewer.AssignedIssues.Nodes
+		allIssues = append(allIssues, issues...)
+
+		fmt.Printf("Fetched %d issues (total: %d)\n", len(issues), len(allIssues))
+
+		pageInfo := resp.Data.Viewer.AssignedIssues.PageInfo
+		if cfg.Preview || cfg.NoPagination || !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+
+	// Filter for only completed state types
+	var doneIssues []Issue
+	for _, issue := range allIssues {
+		if issue.State.Type == "completed" {
+			doneIssues = append(doneIssues, issue)
+		}
+	}
+
+	if !cfg.Preview && !cfg.NoPagination {
+		if err := completeHistories(apiKey, doneIssues); err != nil {
+			return nil, err
+		}
+	}
+
+	return doneIssues, nil
+}
+
+// issueHistoryQuery fetches a further page of one issue's history
+const issueHistoryQuery = `
+query GetIssueHistory($id: String!, $first: Int!, $after: String) {
+	issue(id: $id) {
+		history(first: $first, after: $after) {
+			nodes {
+				createdAt
+				fromAssignee {
+					id
+					name
+				}
+				toAssignee {
+					id
+					name
+				}
+				fromState {
+					name
+					type
+				}
+				toState {
+					name
+					type
+				}
+				fromEstimate
+				toEstimate
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+// completeHistories fetches the rest of the history of every issue whose first
+// page of history events was truncated, so state durations, reassignments,
+// re-estimates and reopens see every event
+func completeHistories(apiKey string, issues []Issue) error {
+	truncated := 0
+	for _, issue := range issues {
+		if issue.History.PageInfo.HasNextPage {
+			truncated++
+		}
+	}
+	if truncated == 0 {
+		return nil
+	}
+
+	fmt.Printf("Fetching the full history of %d issues...\n", truncated)
+	for i := range issues {
+		history := &issues[i].History
+		for history.PageInfo.HasNextPage {
+			variables := map[string]interface{}{
+				"id":    issues[i].ID,
+				"first": defaultPageSize,
+				"after": history.PageInfo.EndCursor,
+			}
+			resp, err := makeGraphQLRequest(apiKey, issueHistoryQuery, variables)
+			if err != nil {
+				return fmt.Errorf("failed to fetch history of %s: %w", issues[i].Identifier, err)
+			}
+			if resp.Data.Issue == nil {
+				break
+			}
+			history.Nodes = append(history.Nodes, resp.Data.Issue.History.Nodes...)
+			history.PageInfo = resp.Data.Issue.History.PageInfo
+		}
+	}
+	return nil
+}
+
+// formatPriority converts priority number to human-readable string
+func formatPriority(priority int) string {
+	priorityMap := map[int]string{
+		0: "No priority",
+		1: "Urgent",
+		2: "High",
+		3: "Medium",
+		4: "Low",
+	}
+	if val, ok := priorityMap[priority]; ok {
+		return val
+	}
+	return "Unknown"
+}
+
+// formatDate formats ISO date string to readable format
+func formatDate(dateStr *string) string {
+	if dateStr == nil {
+		return "N/A"
+	}
+	t, err := time.Parse(time.RFC3339, *dateStr)
+	if err != nil {
+		return *dateStr
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// formatDateString formats ISO date string to readable format (non-pointer version)
+func formatDateString(dateStr string) string {
+	t, err := time.Parse(time.RFC3339, dateStr)
+	if err != nil {
+		return dateStr
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// daysUnassigned approximates how long an issue waited before being assigned to its
+// current assignee, using the earliest history event that assigned it to them.
+// Issues assigned at creation (no such event) report zero.
+func daysUnassigned(issue Issue) float64 {
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil || issue.Assignee.ID == "" {
+		return 0
+	}
+
+	var assignedAt time.Time
+	for _, event := range issue.History.Nodes {
+		if event.ToAssignee == nil || event.ToAssignee.ID != issue.Assignee.ID {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, event.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if assignedAt.IsZero() || t.Before(assignedAt) {
+			assignedAt = t
+		}
+	}
+
+	if assignedAt.IsZero() || assignedAt.Before(created) {
+		return 0
+	}
+	return assignedAt.Sub(created).Hours() / 24
+}
+
+// descriptionWordCount returns the number of words in the issue description
+func descriptionWordCount(issue Issue) int {
+	return len(strings.Fields(issue.Description))
+}
+
+// mentionResult carries the outcome of the background --find-mentions fetch
+type mentionResult struct {
+	issues []Issue
+	err    error
+}
+
+// getMentionedIssues fetches issues with a comment in the date range that mentions
+// the viewer by display name, leaving out issues assigned to the viewer
+func getMentionedIssues(apiKey string, cfg *Config) ([]Issue, error) {
+	viewerQuery := `
+	query GetViewer {
+		viewer {
+			id
+			name
+			displayName
+		}
+	}
+	`
+
+	resp, err := makeGraphQLRequest(apiKey, viewerQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch viewer: %w", err)
+	}
+	viewer := resp.Data.Viewer
+
+	query := `
+	query GetMentionedIssues($filter: IssueFilter!) {
+		issues(first: 100, filter: $filter) {
+			nodes {
+				id
+				identifier
+				title
+				url
+				priority
+				estimate
+				createdAt
+				updatedAt
+				completedAt
+				state {
+					id
+					name
+					type
+				}
+				team {
+					id
+					name
+					key
+					color
+				}
+				assignee {
+					id
+					name
+					email
+				}
+			}
+		}
+	}
+	`
+
+	filter := map[string]interface{}{
+		"comments": map[string]interface{}{
+			"some": map[string]interface{}{
+				"body":      map[string]interface{}{"contains": "@" + viewer.DisplayName},
+				"createdAt": map[string]interface{}{"gte": cfg.PeriodStart, "lte": cfg.PeriodEnd},
+			},
+		},
+	}
+
+	resp, err = makeGraphQLRequest(apiKey, query, map[string]interface{}{"filter": filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mentioned issues: %w", err)
+	}
+
+	var mentioned []Issue
+	for _, issue := range resp.Data.Issues.Nodes {
+		if issue.Assignee.ID != viewer.ID {
+			mentioned = append(mentioned, issue)
+		}
+	}
+	return mentioned, nil
+}
+
+// urgencyWeight maps Linear's priority (1 = Urgent ... 4 = Low, 0 = none) onto
+// a weight that grows with urgency, so that issues without a priority score 0
+func urgencyWeight(priority int) float64 {
+	if priority < 1 || priority > 4 {
+		return 0
+	}
+	return float64(5 - priority)
+}
+
+// computeUrgencyScore weights priority by age: weight * (1 + daysSinceCreation/30)
+func computeUrgencyScore(issue Issue, now time.Time) float64 {
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil {
+		return urgencyWeight(issue.Priority)
+	}
+	days := now.Sub(created).Hours() / 24
+	return urgencyWeight(issue.Priority) * (1 + days/30)
+}
+
+// sortedByUrgency returns a copy of issues ordered by urgency score, highest first
+func sortedByUrgency(issues []Issue, now time.Time) []Issue {
+	sorted := append([]Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return computeUrgencyScore(sorted[i], now) > computeUrgencyScore(sorted[j], now)
+	})
+	return sorted
+}
+
+// getOpenIssues fetches issues assigned to the authenticated user that are not yet
+// completed or canceled, for the urgency ranking in the summary
+func getOpenIssues(apiKey string, cfg *Config) ([]Issue, error) {
+	query := `
+	query GetOpenIssues($first: Int!, $after: String, $filter: IssueFilter!) {
+		viewer {
+			assignedIssues(first: $first, after: $after, filter: $filter) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					priority
+					createdAt
+					state {
+						name
+						type
+					}
+					team {
+						name
+						key
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+	`
+
+	filter := map[string]interface{}{
+		"state": map[string]interface{}{
+			"type": map[string]interface{}{"nin": []string{"completed", "canceled"}},
+		},
+	}
+	if len(cfg.TeamKeys) > 0 {
+		filter["team"] = map[string]interface{}{
+			"key": map[string]interface{}{"in": []string(cfg.TeamKeys)},
+		}
+	}
+
+	var issues []Issue
+	var afterCursor *string
+	for {
+		variables := map[string]interface{}{
+			"filter": filter,
+			"first":  cfg.pageSize(),
+			"after":  afterCursor,
+		}
+		resp, err := makeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch open issues: %w", err)
+		}
+		issues = append(issues, resp.Data.Viewer.AssignedIssues.Nodes...)
+
+		pageInfo := resp.Data.Viewer.AssignedIssues.PageInfo
+		if cfg.NoPagination || !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+	return issues, nil
+}
+
+// printMostUrgent prints the five open issues with the highest urgency score
+func printMostUrgent(openIssues []Issue, now time.Time) {
+	fmt.Println("\nMost urgent open issues:")
+	if len(openIssues) == 0 {
+		fmt.Println("  None")
+		return
+	}
+	for i, issue := range sortedByUrgency(openIssues, now) {
+		if i == 5 {
+			break
+		}
+		fmt.Printf("  %s (%.1f, %s): %s\n", issue.Identifier, computeUrgencyScore(issue, now),
+			formatPriority(issue.Priority), issue.Title)
+	}
+}
+
+// isOverdue reports whether the issue was completed after its due date.
+// dueDate is a calendar date, so completing on the due date is on time.
+func isOverdue(issue Issue) bool {
+	if issue.DueDate == nil || issue.CompletedAt == nil || len(*issue.CompletedAt) < 10 {
+		return false
+	}
+	return (*issue.CompletedAt)[:10] > *issue.DueDate
+}
+
+// overdueIssues returns the issues completed after their due date
+func overdueIssues(issues []Issue) []Issue {
+	var overdue []Issue
+	for _, issue := range issues {
+		if isOverdue(issue) {
+			overdue = append(overdue, issue)
+		}
+	}
+	return overdue
+}
+
+// slaPriorities maps the priority names accepted by --sla to Linear priorities
+var slaPriorities = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"medium": 3,
+	"low":    4,
+}
+
+// parseDayDuration parses a day count with a d suffix (3d) or any Go duration (36h)
+func parseDayDuration(value string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n * 24 * float64(time.Hour)), nil
+	}
+	return time.ParseDuration(value)
+}
+
+// parseSLA parses a --sla value such as "urgent=3d,high=7d" into durations keyed
+// by priority. Thresholds take a day suffix (3d) or any Go duration (36h).
+func parseSLA(value string) (map[int]time.Duration, error) {
+	sla := make(map[int]time.Duration)
+	for _, part := range strings.Split(value, ",") {
+		name, threshold, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("expected priority=duration, got %q", part)
+		}
+		priority, ok := slaPriorities[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown priority %q (use none, urgent, high, medium or low)", name)
+		}
+
+		d, err := parseDayDuration(threshold)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q for %s", threshold, name)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("duration for %s must be positive", name)
+		}
+		sla[priority] = d
+	}
+	return sla, nil
+}
+
+// resolutionDays returns the days from creation to completion
+func resolutionDays(issue Issue) float64 {
+	if issue.CompletedAt == nil {
+		return 0
+	}
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil {
+		return 0
+	}
+	completed, err := time.Parse(time.RFC3339, *issue.CompletedAt)
+	if err != nil {
+		return 0
+	}
+	return completed.Sub(created).Hours() / 24
+}
+
+// commentCount returns the number of comments posted before the issue was completed
+func commentCount(issue Issue) int {
+	if issue.CompletedAt == nil {
+		return len(issue.Comments.Nodes)
+	}
+	count := 0
+	for _, comment := range issue.Comments.Nodes {
+		if comment.CreatedAt <= *issue.CompletedAt {
+			count++
+		}
+	}
+	return count
+}
+
+// pearson returns the Pearson correlation coefficient of xs and ys, or NaN
+// when there are fewer than two pairs or either series is constant
+func pearson(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return math.NaN()
+	}
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return math.NaN()
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// correlateCommentsResolutionTime returns the Pearson correlation between the
+// comments on each completed issue and its days to resolution
+func correlateCommentsResolutionTime(issues []Issue) float64 {
+	var comments, days []float64
+	for _, issue := range issues {
+		if issue.CompletedAt == nil {
+			continue
+		}
+		comments = append(comments, float64(commentCount(issue)))
+		days = append(days, resolutionDays(issue))
+	}
+	return pearson(comments, days)
+}
+
+// exportCommentResolutionToCSV exports each completed issue's comment count and
+// days to resolution, for charting as a scatter plot
+func exportCommentResolutionToCSV(issues []Issue, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Identifier", "Comments", "Resolution Days"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	rows := 0
+	for _, issue := range issues {
+		if issue.CompletedAt == nil {
+			continue
+		}
+		row := []string{issue.Identifier, strconv.Itoa(commentCount(issue)), fmt.Sprintf("%.2f", resolutionDays(issue))}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+		rows++
+	}
+
+	fmt.Printf("✅ Exported comment counts and resolution times for %d issues to %s\n", rows, filename)
+	return nil
+}
+
+// slaMet reports whether the issue was resolved within the SLA for its priority.
+// ok is false when no SLA is defined for that priority.
+func slaMet(issue Issue, sla map[int]time.Duration) (met bool, ok bool) {
+	threshold, ok := sla[issue.Priority]
+	if !ok {
+		return false, false
+	}
+	return resolutionDays(issue) <= threshold.Hours()/24, true
+}
+
+// EstimateAccuracy compares an issue's estimate, converted to days, with the
+// days it actually took from creation to completion
+type EstimateAccuracy struct {
+	Issue        Issue
+	ExpectedDays float64
+	ActualDays   float64
+	// Ratio is actual over expected days: above 1 is an overrun
+	Ratio float64
+}
+
+// estimateAccuracy converts each estimated issue's points into expected days,
+// assuming velocityRatio points are completed per cycle of cycleDurationDays,
+// and compares them with the actual resolution days
+func estimateAccuracy(issues []Issue, cycleDurationDays, velocityRatio float64) []EstimateAccuracy {
+	var result []EstimateAccuracy
+	for _, issue := range issues {
+		if issue.Estimate == nil || *issue.Estimate <= 0 || issue.CompletedAt == nil {
+			continue
+		}
+		expected := *issue.Estimate / velocityRatio * cycleDurationDays
+		actual := resolutionDays(issue)
+		result = append(result, EstimateAccuracy{
+			Issue:        issue,
+			ExpectedDays: expected,
+			ActualDays:   actual,
+			Ratio:        actual / expected,
+		})
+	}
+	return result
+}
+
+// printEstimateAccuracy prints the mean actual/expected ratio and the five worst overruns
+func printEstimateAccuracy(accuracy []EstimateAccuracy) {
+	if len(accuracy) == 0 {
+		return
+	}
+	total := 0.0
+	for _, a := range accuracy {
+		total += a.Ratio
+	}
+	fmt.Printf("\nEstimate accuracy: mean %.2fx actual vs. expected days (%d estimated issues)\n",
+		total/float64(len(accuracy)), len(accuracy))
+
+	sorted := append([]EstimateAccuracy(nil), accuracy...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Ratio > sorted[j].Ratio })
+	fmt.Println("Worst overruns:")
+	for i, a := range sorted {
+		if i == 5 || a.Ratio <= 1 {
+			break
+		}
+		fmt.Printf("  %s (%.1fx, %.1f of %.1f days): %s\n", a.Issue.Identifier, a.Ratio, a.ActualDays, a.ExpectedDays, a.Issue.Title)
+	}
+}
+
+// exportEstimateAccuracyToCSV exports per-issue estimate accuracy to a CSV file
+func exportEstimateAccuracyToCSV(accuracy []EstimateAccuracy, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Identifier", "Title", "Estimate", "Expected Days", "Actual Days", "Ratio"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, a := range accuracy {
+		row := []string{
+			a.Issue.Identifier,
+			a.Issue.Title,
+			fmt.Sprintf("%.0f", *a.Issue.Estimate),
+			fmt.Sprintf("%.1f", a.ExpectedDays),
+			fmt.Sprintf("%.1f", a.ActualDays),
+			fmt.Sprintf("%.2f", a.Ratio),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported estimate accuracy for %d issues to %s\n", len(accuracy), filename)
+	return nil
+}
+
+// exportSLAReportToCSV exports SLA compliance per priority to a CSV file
+func exportSLAReportToCSV(issues []Issue, sla map[int]time.Duration, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Priority", "SLA Days", "Issues", "Met", "Missed", "Compliance %"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	priorities := make([]int, 0, len(sla))
+	for priority := range sla {
+		priorities = append(priorities, priority)
+	}
+	sort.Ints(priorities)
+
+	for _, priority := range priorities {
+		total, met := 0, 0
+		for _, issue := range issues {
+			if issue.Priority != priority {
+				continue
+			}
+			total++
+			if ok, _ := slaMet(issue, sla); ok {
+				met++
+			}
+		}
+
+		compliance := ""
+		if total > 0 {
+			compliance = fmt.Sprintf("%.1f", float64(met)/float64(total)*100)
+		}
+		row := []string{
+			formatPriority(priority),
+			fmt.Sprintf("%g", sla[priority].Hours()/24),
+			fmt.Sprintf("%d", total),
+			fmt.Sprintf("%d", met),
+			fmt.Sprintf("%d", total-met),
+			compliance,
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported SLA compliance to %s\n", filename)
+	return nil
+}
+
+// missingSections returns the --description-template patterns the issue
+// description does not match
+func missingSections(issue Issue, cfg *Config) []string {
+	var missing []string
+	for _, re := range cfg.DescriptionTemplates {
+		if !re.MatchString(issue.Description) {
+			missing = append(missing, re.String())
+		}
+	}
+	return missing
+}
+
+// exportDescriptionViolationsToCSV exports issues missing template sections to a CSV file
+func exportDescriptionViolationsToCSV(issues []Issue, cfg *Config, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Identifier", "Title", "URL", "Team", "Missing Sections"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	count := 0
+	for _, issue := range issues {
+		missing := missingSections(issue, cfg)
+		if len(missing) == 0 {
+			continue
+		}
+		row := []string{issue.Identifier, issue.Title, issue.URL, issue.Team.Name, strings.Join(missing, "; ")}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+		count++
+	}
+
+	fmt.Printf("✅ Exported %d non-compliant descriptions to %s\n", count, filename)
+	return nil
+}
+
+// subscriberCount returns the number of users subscribed to the issue
+func subscriberCount(issue Issue) int {
+	return len(issue.Subscribers.Nodes)
+}
+
+// subscriberNames returns the names of the users subscribed to the issue
+func subscriberNames(issue Issue) []string {
+	names := make([]string, len(issue.Subscribers.Nodes))
+	for i, user := range issue.Subscribers.Nodes {
+		names[i] = user.Name
+	}
+	return names
+}
+
+// subscribedBy reports whether the user with the given email is subscribed to the issue
+func subscribedBy(issue Issue, email string) bool {
+	for _, user := range issue.Subscribers.Nodes {
+		if strings.EqualFold(user.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// printHighVisibility prints the five completed issues with the most subscribers
+func printHighVisibility(issues []Issue) {
+	sorted := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		if subscriberCount(issue) > 0 {
+			sorted = append(sorted, issue)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return subscriberCount(sorted[i]) > subscriberCount(sorted[j])
+	})
+
+	fmt.Println("\nHigh visibility completions:")
+	if len(sorted) == 0 {
+		fmt.Println("  None")
+		return
+	}
+	for i, issue := range sorted {
+		if i == 5 {
+			break
+		}
+		fmt.Printf("  %s (%d subscribers): %s\n", issue.Identifier, subscriberCount(issue), issue.Title)
+	}
+}
+
+// stakeholderPressure returns the issue's subscribers per point of estimate,
+// treating unestimated and sub-point issues as one point
+func stakeholderPressure(issue Issue) float64 {
+	estimate := 1.0
+	if issue.Estimate != nil && *issue.Estimate > 1 {
+		estimate = *issue.Estimate
+	}
+	return float64(subscriberCount(issue)) / estimate
+}
+
+// printHighestPressure prints the five completed issues with the highest stakeholder pressure
+func printHighestPressure(issues []Issue) {
+	sorted := append([]Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return stakeholderPressure(sorted[i]) > stakeholderPressure(sorted[j])
+	})
+
+	fmt.Println("\nHighest stakeholder pressure (subscribers per point):")
+	printed := 0
+	for _, issue := range sorted {
+		if printed == 5 || stakeholderPressure(issue) == 0 {
+			break
+		}
+		fmt.Printf("  %s (%.2f): %s\n", issue.Identifier, stakeholderPressure(issue), issue.Title)
+		printed++
+	}
+	if printed == 0 {
+		fmt.Println("  None")
+	}
+}
+
+// blocksCount returns the number of issues this issue blocks
+func blocksCount(issue Issue) int {
+	count := 0
+	for _, relation := range issue.Relations.Nodes {
+		if relation.Type == "blocks" {
+			count++
+		}
+	}
+	return count
+}
+
+// sortedByBlocks returns a copy of issues ordered by the number of issues they block
+func sortedByBlocks(issues []Issue) []Issue {
+	sorted := append([]Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return blocksCount(sorted[i]) > blocksCount(sorted[j])
+	})
+	return sorted
+}
+
+// printTopBlocking prints the five completed issues that blocked the most others
+func printTopBlocking(issues []Issue) {
+	fmt.Println("\nTop 5 blocking completions:")
+	printed := 0
+	for _, issue := range sortedByBlocks(issues) {
+		if printed == 5 || blocksCount(issue) == 0 {
+			break
+		}
+		fmt.Printf("  %s (blocks %d): %s\n", issue.Identifier, blocksCount(issue), issue.Title)
+		printed++
+	}
+	if printed == 0 {
+		fmt.Println("  None")
+	}
+}
+
+// impactWeights are the multipliers applied to an issue's estimate, subscriber
+// count and blocking count to compute its impact score
+type impactWeights struct {
+	Estimate    float64
+	Subscribers float64
+	Blocks      float64
+}
+
+var defaultImpactWeights = impactWeights{Estimate: 2, Subscribers: 0.5, Blocks: 3}
+
+// parseImpactWeights parses an --impact-weights value such as
+// "estimate=2,subscribers=0.5,blocks=3". Omitted terms keep their defaults.
+func parseImpactWeights(value string) (impactWeights, error) {
+	weights := defaultImpactWeights
+	for _, part := range strings.Split(value, ",") {
+		name, weight, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return weights, fmt.Errorf("expected term=weight, got %q", part)
+		}
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			return weights, fmt.Errorf("invalid weight %q for %s", weight, name)
+		}
+		switch strings.ToLower(name) {
+		case "estimate":
+			weights.Estimate = w
+		case "subscribers":
+			weights.Subscribers = w
+		case "blocks":
+			weights.Blocks = w
+		default:
+			return weights, fmt.Errorf("unknown term %q (use estimate, subscribers or blocks)", name)
+		}
+	}
+	return weights, nil
+}
+
+// impactScore combines the issue's estimate, subscribers and the issues it
+// blocks into one score, treating an unestimated issue as zero points
+func impactScore(issue Issue, w impactWeights) float64 {
+	estimate := 0.0
+	if issue.Estimate != nil {
+		estimate = *issue.Estimate
+	}
+	return estimate*w.Estimate + float64(subscriberCount(issue))*w.Subscribers + float64(blocksCount(issue))*w.Blocks
+}
+
+// sortedByImpact returns a copy of issues ordered by impact score, highest first
+func sortedByImpact(issues []Issue, w impactWeights) []Issue {
+	sorted := append([]Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return impactScore(sorted[i], w) > impactScore(sorted[j], w)
+	})
+	return sorted
+}
+
+// printHighestImpact prints the ten completed issues with the highest impact score
+func printHighestImpact(issues []Issue, w impactWeights) {
+	fmt.Println("\nTop 10 highest-impact completions:")
+	printed := 0
+	for _, issue := range sortedByImpact(issues, w) {
+		if printed == 10 || impactScore(issue, w) == 0 {
+			break
+		}
+		fmt.Printf("  %s (impact %.1f): %s\n", issue.Identifier, impactScore(issue, w), issue.Title)
+		printed++
+	}
+	if printed == 0 {
+		fmt.Println("  None")
+	}
+}
+
+// filterIssues applies the client-side filters selected by command-line flags
+func filterIssues(issues []Issue, cfg *Config) []Issue {
+	var filtered []Issue
+	for _, issue := range issues {
+		if descriptionWordCount(issue) < cfg.MinDescriptionWords {
+			continue
+		}
+		if subscriberCount(issue) < cfg.MinSubscribers {
+			continue
+		}
+		if cfg.FilterSubscriber != "" && !subscribedBy(issue, cfg.FilterSubscriber) {
+			continue
+		}
+		filtered = append(filtered, issue)
+	}
+	return filtered
+}
+
+// reassignmentCount counts history events that moved the issue from one assignee to another
+func reassignmentCount(issue Issue) int {
+	count := 0
+	for _, event := range issue.History.Nodes {
+		if event.FromAssignee != nil && event.ToAssignee != nil && event.FromAssignee.ID != event.ToAssignee.ID {
+			count++
+		}
+	}
+	return count
+}
+
+// estimateChanges counts how often an issue's existing estimate was changed.
+// Setting the first estimate is not counted.
+func estimateChanges(issue Issue) int {
+	count := 0
+	for _, event := range issue.History.Nodes {
+		if event.FromEstimate == nil {
+			continue
+		}
+		if event.ToEstimate == nil || *event.ToEstimate != *event.FromEstimate {
+			count++
+		}
+	}
+	return count
+}
+
+// reestimatedIssues returns the issues whose estimate was changed at least once
+func reestimatedIssues(issues []Issue) []Issue {
+	var reestimated []Issue
+	for _, issue := range issues {
+		if estimateChanges(issue) > 0 {
+			reestimated = append(reestimated, issue)
+		}
+	}
+	return reestimated
+}
+
+// exportReestimatedToCSV exports the re-estimated issues and their change counts to a CSV file
+func exportReestimatedToCSV(issues []Issue, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Identifier", "Title", "Team", "Estimate Changes", "Final Estimate"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, issue := range issues {
+		estimate := "N/A"
+		if issue.Estimate != nil {
+			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+		}
+		row := []string{issue.Identifier, issue.Title, issue.Team.Name, strconv.Itoa(estimateChanges(issue)), estimate}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d re-estimated issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// reopenCount returns the number of times the issue was moved out of a completed
+// state back into an open one (moving it to canceled doesn't count)
+func reopenCount(issue Issue) int {
+	count := 0
+	for _, event := range issue.History.Nodes {
+		if event.FromState == nil || event.ToState == nil || event.FromState.Type != "completed" {
+			continue
+		}
+		if event.ToState.Type != "completed" && event.ToState.Type != "canceled" {
+			count++
+		}
+	}
+	return count
+}
+
+// reopenedIssues returns the issues that were reopened at least once
+func reopenedIssues(issues []Issue) []Issue {
+	var reopened []Issue
+	for _, issue := range issues {
+		if reopenCount(issue) > 0 {
+			reopened = append(reopened, issue)
+		}
+	}
+	return reopened
+}
+
+// exportReopenedToCSV exports the reopened issues and their reopen counts to a CSV file
+func exportReopenedToCSV(issues []Issue, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Identifier", "Title", "Team", "Reopen Count", "Completed At"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, issue := range issues {
+		row := []string{issue.Identifier, issue.Title, issue.Team.Name, strconv.Itoa(reopenCount(issue)), formatDate(issue.CompletedAt)}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d reopened issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// computeStateDurations returns the hours an issue spent in each workflow state,
+// replaying its state-change history from creation until completion
+func computeStateDurations(issue Issue) map[string]float64 {
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil {
+		return nil
+	}
+	end := time.Now()
+	if issue.CompletedAt != nil {
+		if completed, err := time.Parse(time.RFC3339, *issue.CompletedAt); err == nil {
+			end = completed
+		}
+	}
+
+	type stateChange struct {
+		at       time.Time
+		from, to string
+	}
+	var changes []stateChange
+	for _, event := range issue.History.Nodes {
+		if event.FromState == nil || event.ToState == nil {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, event.CreatedAt)
+		if err != nil {
+			continue
+		}
+		changes = append(changes, stateChange{at: at, from: event.FromState.Name, to: event.ToState.Name})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+
+	durations := make(map[string]float64)
+	if len(changes) == 0 {
+		durations[issue.State.Name] = end.Sub(created).Hours()
+		return durations
+	}
+
+	since := created
+	for _, change := range changes {
+		durations[change.from] += change.at.Sub(since).Hours()
+		since = change.at
+	}
+	if last := changes[len(changes)-1].to; end.After(since) {
+		durations[last] += end.Sub(since).Hours()
+	}
+	return durations
+}
+
+// exportStateDurationsToCSV exports per-issue state durations (in hours) to a CSV
+// file with one column per state name
+func exportStateDurationsToCSV(issues []Issue, filename string) error {
+	perIssue := make([]map[string]float64, len(issues))
+	seen := make(map[string]bool)
+	var states []string
+	for i, issue := range issues {
+		perIssue[i] = computeStateDurations(issue)
+		for state := range perIssue[i] {
+			if !seen[state] {
+				seen[state] = true
+				states = append(states, state)
+			}
+		}
+	}
+	sort.Strings(states)
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write(append([]string{"Identifier"}, states...)); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for i, issue := range issues {
+		row := []string{issue.Identifier}
+		for _, state := range states {
+			if hours, ok := perIssue[i][state]; ok {
+				row = append(row, fmt.Sprintf("%.1f", hours))
+			} else {
+				row = append(row, "")
+			}
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported state durations for %d issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// compactIssue is a flattened, minimal representation for JSON export
+type compactIssue struct {
+	Workspace            string             `json:"workspace,omitempty"`
+	Identifier           string             `json:"identifier"`
+	Title                string             `json:"title"`
+	Description          string             `json:"description"`
+	URL                  string             `json:"url"`
+	Team                 string             `json:"team"`
+	Priority             string             `json:"priority"`
+	Estimate             string             `json:"estimate,omitempty"`
+	Labels               []string           `json:"labels,omitempty"`
+	Project              string             `json:"project,omitempty"`
+	Cycle                string             `json:"cycle,omitempty"`
+	CreatedAt            string             `json:"createdAt"`
+	CompletedAt          string             `json:"completedAt"`
+	CustomFields         map[string]string  `json:"customFields,omitempty"`
+	DaysUnassigned       float64            `json:"daysUnassigned"`
+	ReassignmentCount    int                `json:"reassignmentCount"`
+	EstimateChanges      int                `json:"estimateChanges"`
+	ReopenCount          int                `json:"reopenCount"`
+	DescriptionWordCount int                `json:"descriptionWordCount"`
+	StateDurations       map[string]float64 `json:"stateDurations,omitempty"`
+	UrgencyScore         float64            `json:"urgencyScore"`
+	SubscriberCount      int                `json:"subscriberCount"`
+	SubscriberNames      []string           `json:"subscriberNames,omitempty"`
+	BlocksCount          int                `json:"blocksCount"`
+	StakeholderPressure  float64            `json:"stakeholderPressure"`
+	ImpactScore          float64            `json:"impactScore"`
+	DueDate              string             `json:"dueDate,omitempty"`
+	IsOverdue            bool               `json:"isOverdue"`
+	ResolutionDays       float64            `json:"resolutionDays"`
+	CommentCount         int                `json:"commentCount"`
+	SpannedCycles        bool               `json:"spannedCycles"`
+	CycleCapacityPct     *float64           `json:"cycleCapacityPct,omitempty"`
+	SLAMet               *bool              `json:"slaMet,omitempty"`
+	TemplateCompliant    *bool              `json:"templateCompliant,omitempty"`
+	MissingSections      []string           `json:"missingSections,omitempty"`
+}
+
+// labelPaths renders an issue's labels as parent/child paths cut to depth
+// segments (0 keeps the full path), dropping duplicates left by the cut
+func labelPaths(issue Issue, depth int) []string {
+	seen := make(map[string]bool)
+	paths := []string{}
+	for _, label := range issue.Labels.Nodes {
+		segments := []string{label.Name}
+		if label.Parent != nil {
+			segments = []string{label.Parent.Name, label.Name}
+		}
+		if depth > 0 && depth < len(segments) {
+			segments = segments[:depth]
+		}
+
+		path := strings.Join(segments, "/")
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// toCompactIssues flattens issues into their compact export representation
+func toCompactIssues(issues []Issue, cfg *Config) []compactIssue {
+	now := time.Now()
+	compact := make([]compactIssue, len(issues))
+	for i, issue := range issues {
+		labels := labelPaths(issue, cfg.LabelDepth)
+
+		var project, cycle, estimate string
+		if issue.Project != nil {
+			project = issue.Project.Name
+		}
+		if issue.Cycle != nil {
+			cycle = issue.Cycle.Name
+		}
+		if issue.Estimate != nil {
+			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+		}
+
+		compact[i] = compactIssue{
+			Workspace:            issue.Workspace,
+			Identifier:           issue.Identifier,
+			Title:                issue.Title,
+			Description:          issue.Description,
+			URL:                  issue.URL,
+			Team:                 issue.Team.Name,
+			Priority:             formatPriority(issue.Priority),
+			Estimate:             estimate,
+			Labels:               labels,
+			Project:              project,
+			Cycle:                cycle,
+			CreatedAt:            formatDateString(issue.CreatedAt),
+			CompletedAt:          formatDate(issue.CompletedAt),
+			DaysUnassigned:       daysUnassigned(issue),
+			ReassignmentCount:    reassignmentCount(issue),
+			EstimateChanges:      estimateChanges(issue),
+			ReopenCount:          reopenCount(issue),
+			DescriptionWordCount: descriptionWordCount(issue),
+		}
+
+		compact[i].UrgencyScore = computeUrgencyScore(issue, now)
+		compact[i].SubscriberCount = subscriberCount(issue)
+		compact[i].SubscriberNames = subscriberNames(issue)
+		compact[i].BlocksCount = blocksCount(issue)
+		compact[i].StakeholderPressure = stakeholderPressure(issue)
+		compact[i].ImpactScore = impactScore(issue, cfg.ImpactWeights)
+		compact[i].IsOverdue = isOverdue(issue)
+		compact[i].ResolutionDays = resolutionDays(issue)
+		compact[i].CommentCount = commentCount(issue)
+		compact[i].SpannedCycles = spannedCycles(issue, cfg.CycleRanges)
+		compact[i].CycleCapacityPct = cycleCapacityPct(issue, cfg.CycleRanges)
+		if met, ok := slaMet(issue, cfg.SLA); ok {
+			compact[i].SLAMet = &met
+		}
+		if len(cfg.DescriptionTemplates) > 0 {
+			missing := missingSections(issue, cfg)
+			compliant := len(missing) == 0
+			compact[i].TemplateCompliant = &compliant
+			compact[i].MissingSections = missing
+		}
+		if issue.DueDate != nil {
+			compact[i].DueDate = *issue.DueDate
+		}
+
+		if cfg.StateDurations {
+			compact[i].StateDurations = computeStateDurations(issue)
+		}
+
+		if len(issue.CustomFields) > 0 {
+			compact[i].CustomFields = make(map[string]string, len(issue.CustomFields))
+			for _, f := range issue.CustomFields {
+				compact[i].CustomFields[f.Definition.Name] = f.Value
+			}
+		}
+	}
+	return compact
+}
+
+// linearTimestampLayout matches the millisecond UTC timestamps Linear filters use
+const linearTimestampLayout = "2006-01-02T15:04:05.000Z"
+
+// periodTotals holds the completed issue and point totals for a date range
+type periodTotals struct {
+	Start  string  `json:"start"`
+	End    string  `json:"end"`
+	Issues int     `json:"issues"`
+	Points float64 `json:"points"`
+}
+
+// periodComparison pairs the requested period with the one immediately before it
+type periodComparison struct {
+	Current  periodTotals
+	Previous periodTotals
+}
+
+// previousPeriodJSON is the previousPeriod key of the JSON export
+type previousPeriodJSON struct {
+	periodTotals
+	DeltaIssues    int      `json:"deltaIssues"`
+	DeltaPoints    float64  `json:"deltaPoints"`
+	DeltaIssuesPct *float64 `json:"deltaIssuesPct"`
+	DeltaPointsPct *float64 `json:"deltaPointsPct"`
+}
+
+// previousPeriod returns the range of the same length that ends just before start
+func previousPeriod(start, end string) (string, string, error) {
+	s, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid period start %q: %w", start, err)
+	}
+	e, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid period end %q: %w", end, err)
+	}
+
+	// The range is inclusive of its last millisecond
+	length := e.Sub(s) + time.Millisecond
+	prevStart := s.Add(-length)
+	prevEnd := s.Add(-time.Millisecond)
+	return prevStart.UTC().Format(linearTimestampLayout), prevEnd.UTC().Format(linearTimestampLayout), nil
+}
+
+// totalsFor sums the issues and points completed in a period
+func totalsFor(issues []Issue, start, end string) periodTotals {
+	totals := periodTotals{Start: start, End: end, Issues: len(issues)}
+	for _, issue := range issues {
+		if issue.Estimate != nil {
+			totals.Points += *issue.Estimate
+		}
+	}
+	return totals
+}
+
+// percentChange returns the change from prev to cur in percent, or nil when prev is zero
+func percentChange(prev, cur float64) *float64 {
+	if prev == 0 {
+		return nil
+	}
+	pct := (cur - prev) / prev * 100
+	return &pct
+}
+
+// formatDelta renders a signed delta with its percentage, coloured green for
+// growth and red for decline when stdout is a terminal
+func formatDelta(prev, cur float64) string {
+	delta := cur - prev
+	text := fmt.Sprintf("%+.0f", delta)
+	if pct := percentChange(prev, cur); pct != nil {
+		text += fmt.Sprintf(" (%+.1f%%)", *pct)
+	}
+	if !colorOutput || delta == 0 {
+		return text
+	}
+	if delta > 0 {
+		return "\x1b[32m" + text + "\x1b[0m"
+	}
+	return "\x1b[31m" + text + "\x1b[0m"
+}
+
+// printPeriodComparison prints the current period next to the previous one
+func printPeriodComparison(c *periodComparison) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Println("PERIOD COMPARISON")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("Current:  %s to %s\n", c.Current.Start[:10], c.Current.End[:10])
+	fmt.Printf("Previous: %s to %s\n\n", c.Previous.Start[:10], c.Previous.End[:10])
+	fmt.Printf("%-10s %-10s %-10s %s\n", "Metric", "Current", "Previous", "Delta")
+	fmt.Printf("%-10s %-10d %-10d %s\n", "Issues", c.Current.Issues, c.Previous.Issues,
+		formatDelta(float64(c.Previous.Issues), float64(c.Current.Issues)))
+	fmt.Printf("%-10s %-10.0f %-10.0f %s\n", "Points", c.Current.Points, c.Previous.Points,
+		formatDelta(c.Previous.Points, c.Current.Points))
+	fmt.Println(strings.Repeat("=", 60))
+}
+
+// exportSummaryToCSV exports the period comparison totals to a CSV file
+func exportSummaryToCSV(c *periodComparison, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Metric", "Count", "Prev Count", "Delta Count", "Delta %"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	rows := []struct {
+		metric    string
+		cur, prev float64
+	}{
+		{"Issues", float64(c.Current.Issues), float64(c.Previous.Issues)},
+		{"Points", c.Current.Points, c.Previous.Points},
+	}
+	for _, r := range rows {
+		pct := ""
+		if p := percentChange(r.prev, r.cur); p != nil {
+			pct = fmt.Sprintf("%.1f", *p)
+		}
+		row := []string{
+			r.metric,
+			fmt.Sprintf("%.0f", r.cur),
+			fmt.Sprintf("%.0f", r.prev),
+			fmt.Sprintf("%.0f", r.cur-r.prev),
+			pct,
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported period comparison to %s\n", filename)
+	return nil
+}
+
+// exportToJSON exports issues to a compact JSON file. With a period comparison,
+// the issues are wrapped in an object alongside a previousPeriod key.
+func exportToJSON(issues []Issue, filename string, cfg *Config) error {
+	var payload interface{} = toCompactIssues(issues, cfg)
+	if c := cfg.Comparison; c != nil {
+		payload = struct {
+			Issues         []compactIssue     `json:"issues"`
+			PreviousPeriod previousPeriodJSON `json:"previousPeriod"`
+		}{
+			Issues: toCompactIssues(issues, cfg),
+			PreviousPeriod: previousPeriodJSON{
+				periodTotals:   c.Previous,
+				DeltaIssues:    c.Current.Issues - c.Previous.Issues,
+				DeltaPoints:    c.Current.Points - c.Previous.Points,
+				DeltaIssuesPct: percentChange(float64(c.Previous.Issues), float64(c.Current.Issues)),
+				DeltaPointsPct: percentChange(c.Previous.Points, c.Current.Points),
+			},
+		}
+	}
+
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+
+	fmt.Printf("\n✅ Exported %d issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// exportToCSV exports issues to CSV file
+func exportToCSV(issues []Issue, filename string, cfg *Config) error {
+	if len(issues) == 0 {
+		fmt.Println("No issues to export")
+		return nil
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	if err := writeCSV(file, issues, cfg); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Exported %d issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// customFieldNames returns the sorted set of custom field names used across issues
+func customFieldNames(issues []Issue) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, issue := range issues {
+		for _, f := range issue.CustomFields {
+			if !seen[f.Definition.Name] {
+				seen[f.Definition.Name] = true
+				names = append(names, f.Definition.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// compactFieldIndex maps each compactIssue JSON tag name to its struct field index
+func compactFieldIndex() map[string]int {
+	t := reflect.TypeOf(compactIssue{})
+	index := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			index[name] = i
+		}
+	}
+	return index
+}
+
+// parseFields splits a comma-separated --fields value and checks each name
+// against the compactIssue JSON tags
+func parseFields(value string) ([]string, error) {
+	index := compactFieldIndex()
+	var fields, unknown []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := index[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		fields = append(fields, name)
+	}
+
+	if len(unknown) > 0 {
+		valid := make([]string, 0, len(index))
+		for name := range index {
+			valid = append(valid, name)
+		}
+		sort.Strings(valid)
+		return nil, fmt.Errorf("unknown field(s): %s (valid fields: %s)", strings.Join(unknown, ", "), strings.Join(valid, ", "))
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields given")
+	}
+	return fields, nil
+}
+
+// csvValue formats a compact field for a CSV cell: lists are comma-joined,
+// maps become sorted key=value pairs and nil pointers are empty
+func csvValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+		return csvValue(v.Elem())
+	case reflect.Slice:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = csvValue(v.Index(i))
+		}
+		return strings.Join(parts, ", ")
+	case reflect.Map:
+		parts := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			parts = append(parts, fmt.Sprintf("%s=%s", csvValue(key), csvValue(v.MapIndex(key))))
+		}
+		sort.Strings(parts)
+		return strings.Join(parts, "; ")
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// writeFieldsCSV writes only the requested compact fields, in order, using the
+// JSON tag names as the header
+func writeFieldsCSV(w io.Writer, records []compactIssue, fields []string) error {
+	writer := csv.NewWriter(w)
+	index := compactFieldIndex()
+
+	if err := writer.Write(fields); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, record := range records {
+		v := reflect.ValueOf(record)
+		row := make([]string, len(fields))
+		for i, name := range fields {
+			row[i] = csvValue(v.Field(index[name]))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
+
+// writeCSV writes the CSV header and one row per issue to w.
+// Each custom field found in the result set gets its own trailing column.
+// With --fields, only the selected compactIssue fields are written.
+func writeCSV(w io.Writer, issues []Issue, cfg *Config) error {
+	if len(cfg.Fields) > 0 {
+		return writeFieldsCSV(w, toCompactIssues(issues, cfg), cfg.Fields)
+	}
+
+	writer := csv.NewWriter(w)
+	fieldNames := customFieldNames(issues)
+
+	// Write header
+	header := []string{
+		"Identifier", "Title", "URL", "Team", "State", "Priority",
+		"Estimate", "Labels", "Project", "Cycle", "Created At",
+		"Completed At", "Assignee", "Subscribers",
+	}
+	header = append(header, fieldNames...)
+	multiWorkspace := len(issues) > 0 && issues[0].Workspace != ""
+	if multiWorkspace {
+		header = append([]string{"Workspace"}, header...)
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, issue := range issues {
+		labelsStr := strings.Join(labelPaths(issue, cfg.LabelDepth), ", ")
+
+		project := "N/A"
+		if issue.Project != nil {
+			project = issue.Project.Name
+		}
+
+		cycle := "N/A"
+		if issue.Cycle != nil {
+			cycle = issue.Cycle.Name
+		}
+
+		estimate := "N/A"
+		if issue.Estimate != nil {
+			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+		}
+
+		row := []string{
+			issue.Identifier,
+			issue.Title,
+			issue.URL,
+			issue.Team.Name,
+			issue.State.Name,
+			formatPriority(issue.Priority),
+			estimate,
+			labelsStr,
+			project,
+			cycle,
+			formatDateString(issue.CreatedAt),
+			formatDate(issue.CompletedAt),
+			issue.Assignee.Name,
+			strings.Join(subscriberNames(issue), "; "),
+		}
+
+		values := make(map[string]string, len(issue.CustomFields))
+		for _, f := range issue.CustomFields {
+			values[f.Definition.Name] = f.Value
+		}
+		for _, name := range fieldNames {
+			row = append(row, values[name])
+		}
+		if multiWorkspace {
+			row = append([]string{issue.Workspace}, row...)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
+
+// markdownAnchor converts a heading into an HTML anchor id
+func markdownAnchor(heading string) string {
+	var b strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(heading) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastDash = false
+		} else if !lastDash {
+			b.WriteByte('-')
+			lastDash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// markdownCell escapes a value for use inside a Markdown table cell
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// icsTimestampLayout is the RFC 5545 UTC date-time format
+const icsTimestampLayout = "20060102T150405Z"
+
+// icsEscape escapes an RFC 5545 TEXT value
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(s)
+}
+
+// icsFold writes one content line, folding it at 75 octets as RFC 5545
+// requires, without splitting UTF-8 characters
+func icsFold(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward the limit
+		limit = 74
+	}
+	b.WriteString(line + "\r\n")
+}
+
+// icsTimestamp converts a Linear timestamp into an RFC 5545 UTC date-time
+func icsTimestamp(value string) (string, bool) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", false
+	}
+	return t.UTC().Format(icsTimestampLayout), true
+}
+
+// exportToICS exports issues to an iCalendar file with one event per issue,
+// spanning from its creation to its completion
+func exportToICS(issues []Issue, filename string) error {
+	var b strings.Builder
+	icsFold(&b, "BEGIN:VCALENDAR")
+	icsFold(&b, "VERSION:2.0")
+	icsFold(&b, "PRODID:-//linear-extractor//Completed Tickets//EN")
+	icsFold(&b, "CALSCALE:GREGORIAN")
+
+	stamp := time.Now().UTC().Format(icsTimestampLayout)
+	events := 0
+	for _, issue := range issues {
+		if issue.CompletedAt == nil {
+			continue
+		}
+		start, ok := icsTimestamp(issue.CreatedAt)
+		if !ok {
+			continue
+		}
+		end, ok := icsTimestamp(*issue.CompletedAt)
+		if !ok {
+			continue
+		}
+
+		icsFold(&b, "BEGIN:VEVENT")
+		icsFold(&b, "UID:"+issue.ID+"@linear.app")
+		icsFold(&b, "DTSTAMP:"+stamp)
+		icsFold(&b, "DTSTART:"+start)
+		icsFold(&b, "DTEND:"+end)
+		icsFold(&b, "SUMMARY:"+icsEscape(issue.Identifier+" "+issue.Title))
+		if issue.Description != "" {
+			icsFold(&b, "DESCRIPTION:"+icsEscape(issue.Description))
+		}
+		if issue.URL != "" {
+			icsFold(&b, "URL:"+issue.URL)
+		}
+		icsFold(&b, "END:VEVENT")
+		events++
+	}
+	icsFold(&b, "END:VCALENDAR")
+
+	if err := os.WriteFile(filename, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write ICS file: %w", err)
+	}
+
+	fmt.Printf("✅ Exported %d issues as calendar events to %s\n", events, filename)
+	return nil
+}
+
+// exportToMarkdown exports issues to a Markdown file grouped by team
+func exportToMarkdown(issues []Issue, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create Markdown file: %w", err)
+	}
+	defer file.Close()
+
+	if err := writeMarkdown(file, issues); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Exported %d issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// writeMarkdown writes issues grouped by team, preceded by a table of contents
+// linking to an HTML anchor on each team heading
+func writeMarkdown(w io.Writer, issues []Issue) error {
+	byTeam := make(map[string][]Issue)
+	var teams []string
+	for _, issue := range issues {
+		if _, ok := byTeam[issue.Team.Name]; !ok {
+			teams = append(teams, issue.Team.Name)
+		}
+		byTeam[issue.Team.Name] = append(byTeam[issue.Team.Name], issue)
+	}
+	sort.Strings(teams)
+
+	var b strings.Builder
+	b.WriteString("# Linear Completed Tickets\n\n")
+	b.WriteString("## Contents\n\n")
+	for _, team := range teams {
+		fmt.Fprintf(&b, "- [%s](#%s) (%d)\n", team, markdownAnchor(team), len(byTeam[team]))
+	}
+
+	for _, team := range teams {
+		fmt.Fprintf(&b, "\n<a id=\"%s\"></a>\n\n## %s\n\n", markdownAnchor(team), team)
+		b.WriteString("| ID | Title | Priority | Estimate | Completed |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, issue := range byTeam[team] {
+			estimate := "N/A"
+			if issue.Estimate != nil {
+				estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+			}
+			fmt.Fprintf(&b, "| [%s](%s) | %s | %s | %s | %s |\n",
+				issue.Identifier, issue.URL, markdownCell(issue.Title),
+				formatPriority(issue.Priority), estimate, formatDate(issue.CompletedAt))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write Markdown: %w", err)
+	}
+	return nil
+}
+
+// printPreview prints the first few issues in each export format to stdout
+func printPreview(issues []Issue, cfg *Config) error {
+	sample := issues
+	if len(sample) > previewRecordLimit {
+		sample = sample[:previewRecordLimit]
+	}
+
+	fmt.Printf("\n🔍 Preview of %d of %d issues (no files written)\n", len(sample), len(issues))
+
+	fmt.Println("\n--- JSON ---")
+	data, err := json.MarshalIndent(toCompactIssues(sample, cfg), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+
+	fmt.Println("\n--- CSV ---")
+	if err := writeCSV(os.Stdout, sample, cfg); err != nil {
+		return err
+	}
+
+	fmt.Println("\n--- Markdown ---")
+	return writeMarkdown(os.Stdout, sample)
+}
+
+// weeklyVelocity holds the completed work for a single week
+type weeklyVelocity struct {
+	Week     string
+	Issues   int
+	Estimate float64
+}
+
+// weekLabel returns the YYYY-WNN label of the week containing t. Weeks follow
+// ISO 8601 numbering; a Sunday week start moves Sundays into the following week.
+func weekLabel(t time.Time, weekStart time.Weekday) string {
+	if weekStart == time.Sunday && t.Weekday() == time.Sunday {
+		t = t.AddDate(0, 0, 1)
+	}
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// computeWeeklyVelocity groups completed issues by week in the given location
+func computeWeeklyVelocity(issues []Issue, loc *time.Location, weekStart time.Weekday) []weeklyVelocity {
+	byWeek := make(map[string]*weeklyVelocity)
+	for _, issue := range issues {
+		if issue.CompletedAt == nil {
+			continue
+		}
+		completed, err := time.Parse(time.RFC3339, *issue.CompletedAt)
+		if err != nil {
+			continue
+		}
+
+		label := weekLabel(completed.In(loc), weekStart)
+		week, ok := byWeek[label]
+		if !ok {
+			week = &weeklyVelocity{Week: label}
+			byWeek[label] = week
+		}
+		week.Issues++
+		if issue.Estimate != nil {
+			week.Estimate += *issue.Estimate
+		}
+	}
+
+	weeks := make([]weeklyVelocity, 0, len(byWeek))
+	for _, week := range byWeek {
+		weeks = append(weeks, *week)
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Week < weeks[j].Week })
+	return weeks
+}
+
+// printWeeklyDigest prints completed issues and estimate points per week
+func printWeeklyDigest(weeks []weeklyVelocity) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Println("WEEKLY DIGEST")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("%-12s %-10s %-10s\n", "Week", "Issues", "Points")
+	for _, week := range weeks {
+		fmt.Printf("%-12s %-10d %-10.0f\n", week.Week, week.Issues, week.Estimate)
+	}
+	fmt.Println(strings.Repeat("=", 60))
+}
+
+// weekLabelStart returns the Monday that starts an ISO YYYY-WNN week label
+func weekLabelStart(label string) (time.Time, error) {
+	var year, week int
+	if _, err := fmt.Sscanf(label, "%04d-W%02d", &year, &week); err != nil {
+		return time.Time{}, fmt.Errorf("invalid week label %q", label)
+	}
+	// January 4th is always in ISO week 1
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, (week-1)*7), nil
+}
+
+// ForecastPoint is one week of the velocity forecast. Actual is nil for
+// projected weeks.
+type ForecastPoint struct {
+	Week       string
+	Actual     *int
+	Forecast   float64
+	UpperBound float64
+	LowerBound float64
+}
+
+// forecastVelocity fits a least-squares line to the last forecastHistoryWeeks
+// weeks of completed issue counts, counting weeks without completions as zero,
+// and projects it forecastWeeks ahead. The bounds are the fit ±1.96 residual
+// standard deviations, so roughly a 95% interval.
+func forecastVelocity(velocityByWeek map[string]int, forecastWeeks int) []ForecastPoint {
+	labels := make([]string, 0, len(velocityByWeek))
+	for label := range velocityByWeek {
+		labels = append(labels, label)
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	sort.Strings(labels)
+
+	first, err := weekLabelStart(labels[0])
+	if err != nil {
+		return nil
+	}
+	last, err := weekLabelStart(labels[len(labels)-1])
+	if err != nil {
+		return nil
+	}
+
+	var weeks []string
+	var counts []float64
+	for t := first; !t.After(last); t = t.AddDate(0, 0, 7) {
+		label := weekLabel(t, time.Monday)
+		weeks = append(weeks, label)
+		counts = append(counts, float64(velocityByWeek[label]))
+	}
+	if len(weeks) > forecastHistoryWeeks {
+		weeks = weeks[len(weeks)-forecastHistoryWeeks:]
+		counts = counts[len(counts)-forecastHistoryWeeks:]
+	}
+	if len(weeks) < 2 {
+		return nil
+	}
+
+	n := float64(len(counts))
+	var sumX, sumY, sumXY, sumXX float64
+	for i, y := range counts {
+		x := float64(i)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	intercept := (sumY - slope*sumX) / n
+
+	var sumSq float64
+	for i, y := range counts {
+		residual := y - (intercept + slope*float64(i))
+		sumSq += residual * residual
+	}
+	margin := 1.96 * math.Sqrt(sumSq/n)
+
+	point := func(i int, week string) ForecastPoint {
+		fit := intercept + slope*float64(i)
+		return ForecastPoint{
+			Week:       week,
+			Forecast:   math.Max(0, fit),
+			UpperBound: math.Max(0, fit+margin),
+			LowerBound: math.Max(0, fit-margin),
+		}
+	}
+
+	var points []ForecastPoint
+	for i, week := range weeks {
+		p := point(i, week)
+		actual := int(counts[i])
+		p.Actual = &actual
+		points = append(points, p)
+	}
+	next := last
+	for i := 0; i < forecastWeeks; i++ {
+		next = next.AddDate(0, 0, 7)
+		points = append(points, point(len(weeks)+i, weekLabel(next, time.Monday)))
+	}
+	return points
+}
+
+// velocityByWeek counts completed issues per ISO week
+func velocityByWeek(issues []Issue, loc *time.Location) map[string]int {
+	counts := make(map[string]int)
+	for _, week := range computeWeeklyVelocity(issues, loc, time.Monday) {
+		counts[week.Week] = week.Issues
+	}
+	return counts
+}
+
+// printForecast prints the projected completions for the weeks after the data
+func printForecast(points []ForecastPoint) {
+	if len(points) == 0 {
+		return
+	}
+	fmt.Println("\nForecast (issues per week, linear trend of recent weeks):")
+	for _, p := range points {
+		if p.Actual == nil {
+			fmt.Printf("  %s: %.1f (%.1f-%.1f)\n", p.Week, p.Forecast, p.LowerBound, p.UpperBound)
+		}
+	}
+}
+
+// exportForecastToCSV exports the fitted and projected weekly velocity to a CSV file
+func exportForecastToCSV(points []ForecastPoint, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"week", "actual", "forecast", "upper_bound", "lower_bound"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, p := range points {
+		actual := ""
+		if p.Actual != nil {
+			actual = strconv.Itoa(*p.Actual)
+		}
+		row := []string{
+			p.Week,
+			actual,
+			fmt.Sprintf("%.1f", p.Forecast),
+			fmt.Sprintf("%.1f", p.UpperBound),
+			fmt.Sprintf("%.1f", p.LowerBound),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported velocity forecast to %s\n", filename)
+	return nil
+}
+
+// CycleStat holds the completed work of one team cycle and its change from the
+// team's previous cycle
+type CycleStat struct {
+	Team          string
+	CycleName     string
+	Number        int
+	IssueCount    int
+	TotalEstimate float64
+	DeltaCount    int
+	DeltaEstimate float64
+}
+
+// computeCycleTrend groups issues by team cycle, in cycle order within each team,
+// and computes the change from each team's previous cycle
+func computeCycleTrend(issues []Issue) []CycleStat {
+	type cycleKey struct {
+		team   string
+		number int
+	}
+	byCycle := make(map[cycleKey]*CycleStat)
+	for _, issue := range issues {
+		if issue.Cycle == nil {
+			continue
+		}
+		key := cycleKey{issue.Team.Key, issue.Cycle.Number}
+		stat, ok := byCycle[key]
+		if !ok {
+			name := issue.Cycle.Name
+			if name == "" {
+				name = fmt.Sprintf("Cycle %d", issue.Cycle.Number)
+			}
+			stat = &CycleStat{Team: issue.Team.Key, CycleName: name, Number: issue.Cycle.Number}
+			byCycle[key] = stat
+		}
+		stat.IssueCount++
+		if issue.Estimate != nil {
+			stat.TotalEstimate += *issue.Estimate
+		}
+	}
+
+	stats := make([]CycleStat, 0, len(byCycle))
+	for _, stat := range byCycle {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Team != stats[j].Team {
+			return stats[i].Team < stats[j].Team
+		}
+		return stats[i].Number < stats[j].Number
+	})
+
+	for i := 1; i < len(stats); i++ {
+		if stats[i].Team != stats[i-1].Team {
+			continue
+		}
+		stats[i].DeltaCount = stats[i].IssueCount - stats[i-1].IssueCount
+		stats[i].DeltaEstimate = stats[i].TotalEstimate - stats[i-1].TotalEstimate
+	}
+	return stats
+}
+
+// sparkLevels are the block characters used by sparkline, lowest to highest
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders counts as a compact Unicode bar chart, one character per
+// count scaled against the largest. Zero counts use the lowest block and any
+// non-zero count at least the second.
+func sparkline(counts []int) string {
+	maxCount := 0
+	for _, c := range counts {
+		if c > maxCount {
+			maxCount = c
+		}
+	}
+
+	var b strings.Builder
+	for _, c := range counts {
+		level := 0
+		if maxCount > 0 && c > 0 {
+			level = 1 + (c-1)*(len(sparkLevels)-2)/maxCount
+			if c == maxCount {
+				level = len(sparkLevels) - 1
+			}
+		}
+		b.WriteRune(sparkLevels[level])
+	}
+	return b.String()
+}
+
+// stateTypeOrder lists Linear's workflow state types from least to most done
+var stateTypeOrder = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// getStateTypeCounts counts the issues assigned to the viewer that were created
+// in the date range by their current workflow state type, canceled included
+func getStateTypeCounts(apiKey string, cfg *Config) (map[string]int, error) {
+	query := `
+	query GetPeriodStateTypes($first: Int!, $after: String, $filter: IssueFilter!) {
+		viewer {
+			assignedIssues(first: $first, after: $after, includeArchived: true, filter: $filter) {
+				nodes {
+					state {
+						type
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+	`
+
+	filter := issueFilter(cfg)
+	delete(filter, "completedAt")
+	filter["createdAt"] = map[string]interface{}{"gte": cfg.PeriodStart, "lte": cfg.PeriodEnd}
+
+	counts := make(map[string]int)
+	var afterCursor *string
+	for {
+		variables := map[string]interface{}{
+			"filter": filter,
+			"first":  cfg.pageSize(),
+			"after":  afterCursor,
+		}
+		resp, err := makeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issue states: %w", err)
+		}
+
+		for _, issue := range resp.Data.Viewer.AssignedIssues.Nodes {
+			counts[issue.State.Type]++
+		}
+
+		pageInfo := resp.Data.Viewer.AssignedIssues.PageInfo
+		if cfg.NoPagination || !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+	return counts, nil
+}
+
+// printStateTypeChart prints the share of issues in each workflow state type as
+// horizontal bars scaled to the terminal width
+func printStateTypeChart(counts map[string]int) {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	if total == 0 {
+		return
+	}
+
+	width := terminalWidth() - 24
+	if width > 60 {
+		width = 60
+	}
+	if width < 10 {
+		width = 10
+	}
+
+	fmt.Println("\nIssues created in the period by state type:")
+	for _, stateType := range stateTypeOrder {
+		count := counts[stateType]
+		if count == 0 {
+			continue
+		}
+		share := float64(count) / float64(total)
+		filled := int(share*float64(width) + 0.5)
+		fmt.Printf("  %-10s %s%s %3.0f%%\n", stateType+":", strings.Repeat("█", filled), strings.Repeat("░", width-filled), share*100)
+	}
+}
+
+// unestimatedBucket is the computeEstimateDistribution key for issues without an estimate
+const unestimatedBucket = -1
+
+// computeEstimateDistribution counts issues per estimate value, with
+// unestimated issues under unestimatedBucket
+func computeEstimateDistribution(issues []Issue) map[float64]int {
+	dist := make(map[float64]int)
+	for _, issue := range issues {
+		if issue.Estimate == nil {
+			dist[unestimatedBucket]++
+		} else {
+			dist[*issue.Estimate]++
+		}
+	}
+	return dist
+}
+
+// estimateBuckets returns the distribution's keys with the point values in
+// ascending order and the unestimated bucket last
+func estimateBuckets(dist map[float64]int) []float64 {
+	buckets := make([]float64, 0, len(dist))
+	for estimate := range dist {
+		if estimate != unestimatedBucket {
+			buckets = append(buckets, estimate)
+		}
+	}
+	sort.Float64s(buckets)
+	if dist[unestimatedBucket] > 0 {
+		buckets = append(buckets, unestimatedBucket)
+	}
+	return buckets
+}
+
+// estimateBucketLabel names a distribution bucket
+func estimateBucketLabel(estimate float64) string {
+	if estimate == unestimatedBucket {
+		return "unestimated"
+	}
+	return strconv.FormatFloat(estimate, 'f', -1, 64) + " pts"
+}
+
+// printEstimateHistogram prints the number of issues per estimate as horizontal
+// bars scaled to the largest bucket
+func printEstimateHistogram(dist map[float64]int) {
+	if len(dist) == 0 {
+		return
+	}
+	maxCount := 0
+	for _, count := range dist {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+	width := terminalWidth() - 24
+	if width > 60 {
+		width = 60
+	}
+	if width < 10 {
+		width = 10
+	}
+
+	fmt.Println("\nIssues by estimate:")
+	for _, estimate := range estimateBuckets(dist) {
+		count := dist[estimate]
+		fmt.Printf("  %-12s %s %d\n", estimateBucketLabel(estimate)+":", strings.Repeat("█", count*width/maxCount), count)
+	}
+}
+
+// exportEstimateDistributionToCSV exports the number of issues per estimate to a CSV file
+func exportEstimateDistributionToCSV(dist map[float64]int, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Estimate", "Issues"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, estimate := range estimateBuckets(dist) {
+		label := "unestimated"
+		if estimate != unestimatedBucket {
+			label = strconv.FormatFloat(estimate, 'f', -1, 64)
+		}
+		if err := writer.Write([]string{label, strconv.Itoa(dist[estimate])}); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported estimate distribution to %s\n", filename)
+	return nil
+}
+
+// printCycleTrend prints one sparkline of issues completed per cycle for each team
+func printCycleTrend(stats []CycleStat) {
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Println("\nCycle trend (issues per cycle):")
+	for start := 0; start < len(stats); {
+		end := start
+		var counts []int
+		for end < len(stats) && stats[end].Team == stats[start].Team {
+			counts = append(counts, stats[end].IssueCount)
+			end++
+		}
+		last := stats[end-1]
+		fmt.Printf("  %-8s %s  (last: %d issues, %+d vs previous)\n", stats[start].Team, sparkline(counts), last.IssueCount, last.DeltaCount)
+		start = end
+	}
+}
+
+// exportCycleTrendToCSV exports per-cycle totals and deltas to a CSV file
+func exportCycleTrendToCSV(stats []CycleStat, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Team", "Cycle", "Issues", "Points", "Delta Issues", "Delta Points"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, stat := range stats {
+		row := []string{
+			stat.Team,
+			stat.CycleName,
+			fmt.Sprintf("%d", stat.IssueCount),
+			fmt.Sprintf("%.0f", stat.TotalEstimate),
+			fmt.Sprintf("%d", stat.DeltaCount),
+			fmt.Sprintf("%.0f", stat.DeltaEstimate),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d cycles to %s\n", len(stats), filename)
+	return nil
+}
+
+// exportWeeklyVelocityToCSV exports weekly velocity to a CSV file
+func exportWeeklyVelocityToCSV(weeks []weeklyVelocity, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Week", "Issues", "Points"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, week := range weeks {
+		row := []string{
+			week.Week,
+			fmt.Sprintf("%d", week.Issues),
+			fmt.Sprintf("%.0f", week.Estimate),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d weeks to %s\n", len(weeks), filename)
+	return nil
+}
+
+// projectCompletion compares the issues completed in the date range with a project's totals
+type projectCompletion struct {
+	Project          string
+	CompletedInRange int
+	CompletedTotal   int
+	TotalIssues      int
+}
+
+// percent returns the share of the project's issues completed in the date range
+func (p projectCompletion) percent() float64 {
+	if p.TotalIssues == 0 {
+		return 0
+	}
+	return float64(p.CompletedInRange) / float64(p.TotalIssues) * 100
+}
+
+// lastValue returns the most recent entry of a Linear weekly history series
+func lastValue(history []float64) int {
+	if len(history) == 0 {
+		return 0
+	}
+	return int(history[len(history)-1])
+}
+
+// getProjectCompletion fetches issue totals for every project referenced by the
+// issues and pairs them with the number of those issues completed in the range
+func getProjectCompletion(apiKey string, issues []Issue) ([]projectCompletion, error) {
+	completedByProject := make(map[string]int)
+	var ids []string
+	for _, issue := range issues {
+		if issue.Project == nil {
+			continue
+		}
+		if _, ok := completedByProject[issue.Project.ID]; !ok {
+			ids = append(ids, issue.Project.ID)
+		}
+		completedByProject[issue.Project.ID]++
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `
+	query GetProjectCompletion($first: Int!, $ids: [ID!]) {
+		projects(first: $first, filter: { id: { in: $ids } }) {
+			nodes {
+				id
+				name
+				issueCountHistory
+				completedIssueCountHistory
+			}
+		}
+	}
+	`
+
+	var result []projectCompletion
+	for start := 0; start < len(ids); start += defaultPageSize {
+		end := start + defaultPageSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		variables := map[string]interface{}{
+			"first": end - start,
+			"ids":   ids[start:end],
+		}
+		resp, err := makeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch projects: %w", err)
+		}
+
+		for _, project := range resp.Data.Projects.Nodes {
+			result = append(result, projectCompletion{
+				Project:          project.Name,
+				CompletedInRange: completedByProject[project.ID],
+				CompletedTotal:   lastValue(project.CompletedIssueCountHistory),
+				TotalIssues:      lastValue(project.IssueCountHistory),
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Project < result[j].Project })
+	return result, nil
+}
+
+// printProjectCompletion prints the per-project completion table
+func printProjectCompletion(projects []projectCompletion) {
+	fmt.Println("\n" + strings.Repeat("=", 90))
+	fmt.Println("PROJECT COMPLETION")
+	fmt.Println(strings.Repeat("=", 90))
+	fmt.Printf("%-40s %-12s %-12s %-12s %-10s\n", "Project", "In Range", "Completed", "Total", "% In Range")
+	for _, p := range projects {
+		name := p.Project
+		if len(name) > 40 {
+			name = name[:37] + "..."
+		}
+		fmt.Printf("%-40s %-12d %-12d %-12d %-10.1f\n",
+			name, p.CompletedInRange, p.CompletedTotal, p.TotalIssues, p.percent())
+	}
+	fmt.Println(strings.Repeat("=", 90))
+}
+
+// exportProjectCompletionToCSV exports per-project completion to a CSV file
+func exportProjectCompletionToCSV(projects []projectCompletion, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Project", "Completed In Range", "Completed Total", "Total Issues", "Percent Completed In Range"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, p := range projects {
+		row := []string{
+			p.Project,
+			fmt.Sprintf("%d", p.CompletedInRange),
+			fmt.Sprintf("%d", p.CompletedTotal),
+			fmt.Sprintf("%d", p.TotalIssues),
+			fmt.Sprintf("%.1f", p.percent()),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d projects to %s\n", len(projects), filename)
+	return nil
+}
+
+// printMostReassigned prints the five issues with the most reassignments
+func printMostReassigned(issues []Issue) {
+	sorted := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		if reassignmentCount(issue) > 0 {
+			sorted = append(sorted, issue)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return reassignmentCount(sorted[i]) > reassignmentCount(sorted[j])
+	})
+
+	fmt.Println("\nMost reassigned issues:")
+	if len(sorted) == 0 {
+		fmt.Println("  None")
+		return
+	}
+	for i, issue := range sorted {
+		if i == 5 {
+			break
+		}
+		fmt.Printf("  %s (%d reassignments): %s\n", issue.Identifier, reassignmentCount(issue), issue.Title)
+	}
+}
+
+// printSummary prints a summary of the issues, followed by the most urgent
+// open issues when they could be fetched
+func printSummary(issues []Issue, openIssues []Issue, cfg *Config) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Println("SUMMARY")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("Total completed issues: %d\n", len(issues))
+	fmt.Println("Date range: January 2025 - February 2026")
+
+	if len(issues) > 0 {
+		// Group by team
+		teams := make(map[string]int)
+		for _, issue := range issues {
+			teams[issue.Team.Name]++
+		}
+
+		fmt.Println("\nIssues by team:")
+		for team, count := range teams {
+			fmt.Printf("  %s: %d\n", team, count)
+		}
+
+		// Group by priority
+		priorities := make(map[string]int)
+		for _, issue := range issues {
+			priority := formatPriority(issue.Priority)
+			priorities[priority]++
+		}
+
+		fmt.Println("\nIssues by priority:")
+		for priority, count := range priorities {
+			fmt.Printf("  %s: %d\n", priority, count)
+		}
+
+		// Time spent unassigned
+		var totalUnassigned, maxUnassigned float64
+		for _, issue := range issues {
+			days := daysUnassigned(issue)
+			totalUnassigned += days
+			if days > maxUnassigned {
+				maxUnassigned = days
+			}
+		}
+		fmt.Println("\nDays unassigned before assignment:")
+		fmt.Printf("  Mean: %.1f\n", totalUnassigned/float64(len(issues)))
+		fmt.Printf("  Max:  %.1f\n", maxUnassigned)
+
+		// Description quality
+		totalWords := 0
+		var emptyDescriptions []Issue
+		for _, issue := range issues {
+			words := descriptionWordCount(issue)
+			totalWords += words
+			if words == 0 {
+				emptyDescriptions = append(emptyDescriptions, issue)
+			}
+		}
+		fmt.Printf("\nMean description word count: %.1f\n", float64(totalWords)/float64(len(issues)))
+		if len(emptyDescriptions) > 0 {
+			fmt.Printf("Issues with empty descriptions: %d\n", len(emptyDescriptions))
+			for i, issue := range emptyDescriptions {
+				if i == 10 {
+					break
+				}
+				fmt.Printf("  %s: %s\n", issue.Identifier, issue.Title)
+			}
+		}
+
+		if len(cfg.DescriptionTemplates) > 0 {
+			compliant := 0
+			for _, issue := range issues {
+				if len(missingSections(issue, cfg)) == 0 {
+					compliant++
+				}
+			}
+			fmt.Printf("Description template compliance: %.1f%% (%d of %d)\n",
+				float64(compliant)/float64(len(issues))*100, compliant, len(issues))
+		}
+
+		if cfg.TrackReassignments {
+			printMostReassigned(issues)
+		}
+
+		if len(cfg.CycleRanges) > 0 {
+			overruns := len(cycleOverruns(issues, cfg.CycleRanges))
+			fmt.Printf("\nIssues that spanned cycles: %d (%.1f%%)\n", overruns, float64(overruns)/float64(len(issues))*100)
+			printCycleFit(computeCycleFit(issues, cfg.CycleRanges))
+		}
+
+		reopened := len(reopenedIssues(issues))
+		fmt.Printf("\nReopened issues: %d (%.1f%%)\n", reopened, float64(reopened)/float64(len(issues))*100)
+
+		if cfg.DetectReestimates {
+			reestimated := len(reestimatedIssues(issues))
+			fmt.Printf("\nIssues re-estimated: %d (%.1f%%)\n", reestimated, float64(reestimated)/float64(len(issues))*100)
+		}
+
+		printHighVisibility(issues)
+		printTopBlocking(issues)
+		printHighestImpact(issues, cfg.ImpactWeights)
+		printHighestPressure(issues)
+		if !cfg.NoCharts {
+			printStateTypeChart(cfg.StateTypeCounts)
+			printCycleTrend(computeCycleTrend(issues))
+			printEstimateHistogram(computeEstimateDistribution(issues))
+		}
+
+		if cfg.VelocityRatio > 0 {
+			printEstimateAccuracy(estimateAccuracy(issues, cfg.CycleDays, cfg.VelocityRatio))
+		}
+
+		printForecast(forecastVelocity(velocityByWeek(issues, cfg.Location), forecastWeeks))
+		if r := correlateCommentsResolutionTime(issues); !math.IsNaN(r) {
+			fmt.Printf("\nComments vs resolution time: r = %.2f\n", r)
+		}
+		printTeamBreakdown(cfg.TeamBreakdown, cfg)
+
+		withDueDate := 0
+		for _, issue := range issues {
+			if issue.DueDate != nil {
+				withDueDate++
+			}
+		}
+		fmt.Printf("\nOverdue completions: %d of %d issues with a due date\n", len(overdueIssues(issues)), withDueDate)
+	}
+
+	if !cfg.NoUrgentIssues {
+		printMostUrgent(openIssues, time.Now())
+	}
+
+	fmt.Println(strings.Repeat("=", 60))
+}
+
+// issueTableHeader is the column header shared by the static and interactive tables
+var issueTableHeader = fmt.Sprintf("%-15s %-50s %-20s %-20s", "ID", "Title", "Team", "Completed")
+
+// teamPalette is the set of 256-colour codes teams are hashed onto when Linear
+// doesn't return a colour for them
+var teamPalette = []int{33, 37, 70, 99, 130, 136, 160, 166, 170, 172, 178, 203}
+
+// teamColor returns the ANSI escape for a team's brand colour, falling back to
+// a colour picked from the palette by hashing the team key
+func teamColor(team Team) string {
+	var r, g, b int
+	if n, _ := fmt.Sscanf(team.Color, "#%02x%02x%02x", &r, &g, &b); n == 3 {
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r, g, b)
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(team.Key))
+	return fmt.Sprintf("\x1b[38;5;%dm", teamPalette[h.Sum32()%uint32(len(teamPalette))])
+}
+
+// issueTableRow formats a single issue as a fixed-width table row, rendering
+// the team name in its colour when colored is set
+func issueTableRow(issue Issue, colored bool) string {
+	identifier := issue.Identifier
+	if len(identifier) > 15 {
+		identifier = identifier[:15]
+	}
+
+	title := issue.Title
+	if len(title) > 50 {
+		title = title[:47] + "..."
+	}
+
+	team := issue.Team.Name
+	if len(team) > 20 {
+		team = team[:20]
+	}
+	team = fmt.Sprintf("%-20s", team)
+	if colored {
+		team = teamColor(issue.Team) + team + "\x1b[0m"
+	}
+
+	completed := formatDate(issue.CompletedAt)
+	if len(completed) > 20 {
+		completed = completed[:20]
+	}
+
+	return fmt.Sprintf("%-15s %-50s %s %-20s", identifier, title, team, completed)
+}
+
+// printIssuesTable prints issues in a formatted table
+func printIssuesTable(issues []Issue) {
+	if len(issues) == 0 {
+		fmt.Println("\nNo issues found.")
+		return
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 120))
+	fmt.Println(issueTableHeader)
+	fmt.Println(strings.Repeat("=", 120))
+
+	for _, issue := range issues {
+		fmt.Println(issueTableRow(issue, colorOutput))
+	}
+
+	fmt.Println(strings.Repeat("=", 120))
+}
+
+// tuiRow is a single selectable line in the interactive view
+type tuiRow struct {
+	Text string
+	URL  string
+}
+
+// colorOutput reports whether output may use ANSI colours; it is cleared by
+// --no-color (or NO_COLOR) and when stdout is not a terminal
+var colorOutput = isTerminal(os.Stdout)
+
+// isTerminal reports whether f is attached to a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// stty runs stty against the controlling terminal and returns its output
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// terminalHeight returns the number of rows in the terminal, defaulting to 24
+func terminalHeight() int {
+	if out, err := stty("size"); err == nil {
+		var rows, cols int
+		if _, err := fmt.Sscanf(out, "%d %d", &rows, &cols); err == nil && rows > 0 {
+			return rows
+		}
+	}
+	return 24
+}
+
+// terminalWidth returns the number of columns in the terminal, defaulting to 80
+func terminalWidth() int {
+	if out, err := stty("size"); err == nil {
+		var rows, cols int
+		if _, err := fmt.Sscanf(out, "%d %d", &rows, &cols); err == nil && cols > 0 {
+			return cols
+		}
+	}
+	return 80
+}
+
+// openURL opens url in the default browser
+func openURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// filterRows returns the rows whose text contains query, case-insensitively
+func filterRows(rows []tuiRow, query string) []tuiRow {
+	if query == "" {
+		return rows
+	}
+	query = strings.ToLower(query)
+	var matched []tuiRow
+	for _, row := range rows {
+		if strings.Contains(strings.ToLower(row.Text), query) {
+			matched = append(matched, row)
+		}
+	}
+	return matched
+}
+
+// runInteractive shows rows in a scrollable view. Arrow keys (or j/k) move the
+// selection, Enter opens the selected URL, / searches and q quits. The terminal
+// is switched to raw mode with stty for the duration.
+func runInteractive(header string, rows []tuiRow) error {
+	saved, err := stty("-g")
+	if err != nil {
+		return fmt.Errorf("failed to read terminal state: %w", err)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return fmt.Errorf("failed to enable raw mode: %w", err)
+	}
+	defer func() {
+		stty(saved)
+		fmt.Print("\x1b[?25h\x1b[H\x1b[2J")
+	}()
+
+	reader := bufio.NewReader(os.Stdin)
+	visible := rows
+	selected, offset := 0, 0
+	query, status := "", ""
+	searching := false
+
+	for {
+		height := terminalHeight() - 3
+		if height < 1 {
+			height = 1
+		}
+		if selected < offset {
+			offset = selected
+		}
+		if selected >= offset+height {
+			offset = selected - height + 1
+		}
+
+		var b strings.Builder
+		b.WriteString("\x1b[?25l\x1b[H\x1b[2J")
+		b.WriteString("\x1b[1m" + header + "\x1b[0m\r\n")
+		for i := offset; i < len(visible) && i < offset+height; i++ {
+			if i == selected {
+				b.WriteString("\x1b[7m" + visible[i].Text + "\x1b[0m\r\n")
+			} else {
+				b.WriteString(visible[i].Text + "\r\n")
+			}
+		}
+		fmt.Fprintf(&b, "\x1b[%d;1H", height+2)
+		if searching {
+			b.WriteString("/" + query)
+		} else {
+			fmt.Fprintf(&b, "%d/%d  ↑/↓ move  Enter open  / search  q quit  %s",
+				min(selected+1, len(visible)), len(visible), status)
+		}
+		fmt.Print(b.String())
+
+		key, err := reader.ReadByte()
+		if err != nil {
+			return nil
+		}
+
+		// Escape sequences (arrow keys) arrive together; a lone ESC does not
+		if key == 0x1b && reader.Buffered() >= 2 {
+			seq := make([]byte, 2)
+			if _, err := io.ReadFull(reader, seq); err != nil {
+				return nil
+			}
+			if searching || seq[0] != '[' {
+				continue
+			}
+			switch seq[1] {
+			case 'A':
+				key = 'k'
+			case 'B':
+				key = 'j'
+			default:
+				continue
+			}
+		}
+
+		if searching {
+			switch key {
+			case '\r', '\n':
+				searching = false
+			case 0x1b:
+				searching = false
+				query = ""
+			case 127, 8:
+				if len(query) > 0 {
+					query = query[:len(query)-1]
+				}
+			default:
+				if key >= 32 {
+					query += string(key)
+				}
+			}
+			visible = filterRows(rows, query)
+			selected, offset = 0, 0
+			continue
+		}
+
+		status = ""
+		switch key {
+		case 'q', 3:
+			return nil
+		case '/':
+			searching = true
+			query = ""
+		case 'k':
+			if selected > 0 {
+				selected--
+			}
+		case 'j':
+			if selected < len(visible)-1 {
+				selected++
+			}
+		case '\r', '\n':
+			if len(visible) == 0 {
+				continue
+			}
+			if err := openURL(visible[selected].URL); err != nil {
+				status = "failed to open browser: " + err.Error()
+			} else {
+				status = "opened " + visible[selected].URL
+			}
+		}
+	}
+}
+
+// showIssues displays issues in the interactive view when requested and
+// possible, falling back to the static table otherwise
+func showIssues(issues []Issue, cfg *Config) {
+	if !cfg.Interactive || len(issues) == 0 {
+		printIssuesTable(issues)
+		return
+	}
+	if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		fmt.Println("\nℹ️  Not running in a terminal, showing the static table instead")
+		printIssuesTable(issues)
+		return
+	}
+
+	rows := make([]tuiRow, len(issues))
+	for i, issue := range issues {
+		rows[i] = tuiRow{Text: issueTableRow(issue, false), URL: issue.URL}
+	}
+	if err := runInteractive(issueTableHeader, rows); err != nil {
+		fmt.Printf("❌ Error in interactive mode: %v\n", err)
+		printIssuesTable(issues)
+	}
+}
+
+// slackMessage is the request body for Slack's chat.postMessage
+type slackMessage struct {
+	Channel  string       `json:"channel"`
+	Text     string       `json:"text"`
+	Blocks   []slackBlock `json:"blocks,omitempty"`
+	ThreadTS string       `json:"thread_ts,omitempty"`
+}
+
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	TS    string `json:"ts"`
+}
+
+// slackPoster posts messages to a Slack channel, spacing them to respect
+// the Tier-1 limit of one message per second
+type slackPoster struct {
+	token    string
+	channel  string
+	lastPost time.Time
+}
+
+// post sends a message and returns its timestamp, for use as a thread parent
+func (p *slackPoster) post(text string, blocks []slackBlock, threadTS string) (string, error) {
+	if wait := slackPostInterval - time.Since(p.lastPost); wait > 0 {
+		time.Sleep(wait)
+	}
+	p.lastPost = time.Now()
+
+	jsonBody, err := json.Marshal(slackMessage{
+		Channel:  p.channel,
+		Text:     text,
+		Blocks:   blocks,
+		ThreadTS: threadTS,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Slack message: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", slackPostMessageURL, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create Slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send Slack request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Slack response: %w", err)
+	}
+
+	var slackResp slackResponse
+	if err := json.Unmarshal(body, &slackResp); err != nil {
+		return "", fmt.Errorf("failed to unmarshal Slack response: %w", err)
+	}
+	if !slackResp.OK {
+		return "", fmt.Errorf("Slack API error: %s", slackResp.Error)
+	}
+	return slackResp.TS, nil
+}
+
+// mrkdwn returns a Slack mrkdwn text object
+func mrkdwn(text string) slackText {
+	return slackText{Type: "mrkdwn", Text: text}
+}
+
+// issueSlackBlocks renders an issue as Slack blocks
+func issueSlackBlocks(issue Issue) []slackBlock {
+	estimate := "N/A"
+	if issue.Estimate != nil {
+		estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+	}
+	title := mrkdwn(fmt.Sprintf("*<%s|%s>* %s", issue.URL, issue.Identifier, issue.Title))
+	return []slackBlock{
+		{Type: "section", Text: &title},
+		{Type: "section", Fields: []slackText{
+			mrkdwn("*Team:* " + issue.Team.Name),
+			mrkdwn("*Priority:* " + formatPriority(issue.Priority)),
+			mrkdwn("*Estimate:* " + estimate),
+			mrkdwn("*Completed:* " + formatDate(issue.CompletedAt)),
+		}},
+	}
+}
+
+// exportToSlack posts issues to a Slack channel. By default each issue is its own
+// message; with a batch size, a summary message is posted and the issues follow
+// as thread replies of up to that many issues each.
+func exportToSlack(issues []Issue, token string, cfg *Config) error {
+	poster := &slackPoster{token: token, channel: cfg.SlackChannel}
+
+	if cfg.SlackBatchSize <= 0 {
+		for _, issue := range issues {
+			text := fmt.Sprintf("%s %s", issue.Identifier, issue.Title)
+			if _, err := poster.post(text, issueSlackBlocks(issue), ""); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("✅ Posted %d issues to Slack channel %s\n", len(issues), cfg.SlackChannel)
+		return nil
+	}
+
+	batchSize := cfg.SlackBatchSize
+
+	summary := fmt.Sprintf("✅ %d Linear issues completed between %s and %s", len(issues), startDate[:10], endDate[:10])
+	summaryText := mrkdwn("*" + summary + "*")
+	threadTS, err := poster.post(summary, []slackBlock{{Type: "section", Text: &summaryText}}, "")
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(issues); start += batchSize {
+		end := start + batchSize
+		if end > len(issues) {
+			end = len(issues)
+		}
+		var blocks []slackBlock
+		for i, issue := range issues[start:end] {
+			if i > 0 {
+				blocks = append(blocks, slackBlock{Type: "divider"})
+			}
+			blocks = append(blocks, issueSlackBlocks(issue)...)
+		}
+		text := fmt.Sprintf("Issues %d-%d of %d", start+1, end, len(issues))
+		if _, err := poster.post(text, blocks, threadTS); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("✅ Posted %d issues to a Slack thread in %s\n", len(issues), cfg.SlackChannel)
+	return nil
+}
+
+const (
+	findLabelQuery = `
+	query FindLabel($name: String!) {
+		issueLabels(first: 1, filter: { name: { eqIgnoreCase: $name }, team: { null: true } }) {
+			nodes {
+				id
+				name
+			}
+		}
+	}
+	`
+
+	createLabelMutation = `
+	mutation CreateLabel($name: String!) {
+		issueLabelCreate(input: { name: $name }) {
+			success
+			issueLabel {
+				id
+				name
+			}
+		}
+	}
+	`
+
+	updateLabelsMutation = `
+	mutation TagIssue($id: String!, $labelIds: [String!]!) {
+		issueUpdate(id: $id, input: { labelIds: $labelIds }) {
+			success
+		}
+	}
+	`
+)
+
+// printMutation prints a GraphQL mutation and its variables for --dry-run-mutations
+func printMutation(mutation string, variables map[string]interface{}) {
+	data, _ := json.Marshal(variables)
+	fmt.Printf("%s\n  variables: %s\n", strings.TrimSpace(mutation), data)
+}
+
+// findOrCreateLabel returns the ID of the workspace label with the given name,
+// creating it when it does not exist. Team labels of the same name are ignored,
+// since they can't be applied to issues in other teams. In dry-run mode a
+// missing label is not created.
+func findOrCreateLabel(apiKey, name string, dryRun bool) (string, error) {
+	resp, err := makeGraphQLRequest(apiKey, findLabelQuery, map[string]interface{}{"name": name})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up label %q: %w", name, err)
+	}
+	if len(resp.Data.IssueLabels.Nodes) > 0 {
+		return resp.Data.IssueLabels.Nodes[0].ID, nil
+	}
+
+	variables := map[string]interface{}{"name": name}
+	if dryRun {
+		printMutation(createLabelMutation, variables)
+		return "<new-label-id>", nil
+	}
+
+	resp, err = makeGraphQLRequest(apiKey, createLabelMutation, variables)
+	if err != nil {
+		return "", fmt.Errorf("failed to create label %q: %w", name, err)
+	}
+	if resp.Data.IssueLabelCreate == nil || !resp.Data.IssueLabelCreate.Success {
+		return "", fmt.Errorf("failed to create label %q", name)
+	}
+	fmt.Printf("🏷️  Created label %q\n", name)
+	return resp.Data.IssueLabelCreate.IssueLabel.ID, nil
+}
+
+// tagIssues attaches the --tag-as label to every issue that does not have it yet.
+// issueUpdate replaces the label set, so each issue's existing labels are kept.
+func tagIssues(apiKey string, issues []Issue, cfg *Config) error {
+	labelID, err := findOrCreateLabel(apiKey, cfg.TagAs, cfg.DryRunMutations)
+	if err != nil {
+		return err
+	}
+
+	tagged := 0
+	for _, issue := range issues {
+		labelIDs := []string{labelID}
+		alreadyTagged := false
 		for _, label := range issue.Labels.Nodes {
-			labels = append(labels, label.Name)
+			if label.ID == labelID {
+				alreadyTagged = true
+				break
+			}
+			labelIDs = append(labelIDs, label.ID)
+		}
+		if alreadyTagged {
+			continue
 		}
-		labelsStr := strings.Join(labels, ", ")
 
-		project := "N/A"
-		if issue.Project != nil {
-			project = issue.Project.Name
+		variables := map[string]interface{}{"id": issue.ID, "labelIds": labelIDs}
+		if cfg.DryRunMutations {
+			printMutation(updateLabelsMutation, variables)
+			tagged++
+			continue
+		}
+
+		resp, err := makeGraphQLRequest(apiKey, updateLabelsMutation, variables)
+		if err != nil {
+			return fmt.Errorf("failed to tag %s: %w", issue.Identifier, err)
+		}
+		if resp.Data.IssueUpdate == nil || !resp.Data.IssueUpdate.Success {
+			return fmt.Errorf("failed to tag %s", issue.Identifier)
+		}
+		tagged++
+	}
+
+	if cfg.DryRunMutations {
+		fmt.Printf("ℹ️  Dry run: %d issues would be tagged %q\n", tagged, cfg.TagAs)
+	} else {
+		fmt.Printf("✅ Tagged %d issues with %q\n", tagged, cfg.TagAs)
+	}
+	return nil
+}
+
+// linearWorkspace is one entry of the --linear-key-file JSON array
+type linearWorkspace struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// loadWorkspaces reads the workspace names and API keys from a JSON file
+func loadWorkspaces(filename string) ([]linearWorkspace, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var workspaces []linearWorkspace
+	if err := json.Unmarshal(data, &workspaces); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+	if len(workspaces) == 0 {
+		return nil, fmt.Errorf("key file %s lists no workspaces", filename)
+	}
+	for i, ws := range workspaces {
+		if ws.Name == "" || ws.Key == "" {
+			return nil, fmt.Errorf("key file entry %d needs both a name and a key", i+1)
+		}
+	}
+	return workspaces, nil
+}
+
+// workspaceSlug turns a workspace name into a filename-safe suffix
+func workspaceSlug(name string) string {
+	slug := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(name))
+	return strings.Trim(slug, "_")
+}
+
+// workspaceResult holds the issues extracted from one workspace
+type workspaceResult struct {
+	Name   string
+	Issues []Issue
+}
+
+// printWorkspaceComparison prints issue and point totals side by side per workspace
+func printWorkspaceComparison(results []workspaceResult) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Println("CROSS-WORKSPACE SUMMARY")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("%-25s %-10s %-10s %-10s\n", "Workspace", "Issues", "Points", "Teams")
+
+	totalIssues, totalPoints := 0, 0.0
+	for _, result := range results {
+		points := 0.0
+		teams := make(map[string]bool)
+		for _, issue := range result.Issues {
+			if issue.Estimate != nil {
+				points += *issue.Estimate
+			}
+			teams[issue.Team.Key] = true
+		}
+		totalIssues += len(result.Issues)
+		totalPoints += points
+		fmt.Printf("%-25s %-10d %-10.0f %-10d\n", result.Name, len(result.Issues), points, len(teams))
+	}
+	fmt.Printf("%-25s %-10d %-10.0f\n", "Total", totalIssues, totalPoints)
+	fmt.Println(strings.Repeat("=", 60))
+}
+
+// workspaceFlags are the flags that apply to --linear-key-file runs, which only
+// filter issues and write the JSON and CSV exports
+var workspaceFlags = map[string]bool{
+	"linear-key-file":       true,
+	"team":                  true,
+	"no-pagination":         true,
+	"no-color":              true,
+	"no-charts":             true,
+	"no-urgent-issues":      true,
+	"custom-fields":         true,
+	"linear-api-url":        true,
+	"linear-insecure":       true,
+	"timezone":              true,
+	"fields":                true,
+	"label-depth":           true,
+	"filter-subscriber":     true,
+	"min-subscribers":       true,
+	"min-description-words": true,
+	"description-template":  true,
+	"impact-weights":        true,
+	"sla":                   true,
+}
+
+// unsupportedWorkspaceFlags returns the flags set on the command line that
+// --linear-key-file runs would ignore
+func unsupportedWorkspaceFlags() []string {
+	var unsupported []string
+	flag.Visit(func(f *flag.Flag) {
+		if !workspaceFlags[f.Name] {
+			unsupported = append(unsupported, "--"+f.Name)
+		}
+	})
+	return unsupported
+}
+
+// runWorkspaces runs the extraction against every workspace in --linear-key-file,
+// exporting each workspace to its own files and all of them to the combined files
+func runWorkspaces(cfg *Config) {
+	if unsupported := unsupportedWorkspaceFlags(); len(unsupported) > 0 {
+		fmt.Printf("\n❌ Error: --linear-key-file only writes the JSON and CSV exports and doesn't support %s\n", strings.Join(unsupported, ", "))
+		os.Exit(1)
+	}
+
+	workspaces, err := loadWorkspaces(cfg.LinearKeyFile)
+	if err != nil {
+		fmt.Printf("\n❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	var results []workspaceResult
+	var combined []Issue
+	for _, ws := range workspaces {
+		fmt.Printf("\n🏢 Workspace: %s\n", ws.Name)
+
+		// Team keys and the cached team list are per workspace
+		wsCfg := *cfg
+		wsCfg.TeamKeys = append(stringSliceFlag(nil), cfg.TeamKeys...)
+		wsCfg.Teams = nil
+		if len(wsCfg.TeamKeys) > 0 {
+			if err := validateTeams(ws.Key, &wsCfg); err != nil {
+				fmt.Printf("❌ Skipping %s: %v\n", ws.Name, err)
+				continue
+			}
+		}
+
+		issues, err := getCompletedIssues(ws.Key, &wsCfg)
+		if err != nil {
+			fmt.Printf("❌ Error fetching issues for %s: %v\n", ws.Name, err)
+			continue
+		}
+		issues = filterIssues(issues, &wsCfg)
+		for i := range issues {
+			issues[i].Workspace = ws.Name
+		}
+
+		results = append(results, workspaceResult{Name: ws.Name, Issues: issues})
+		combined = append(combined, issues...)
+
+		if len(issues) > 0 {
+			slug := workspaceSlug(ws.Name)
+			if err := exportToJSON(issues, "linear_completed_tickets_"+slug+".json", cfg); err != nil {
+				fmt.Printf("❌ Error exporting JSON: %v\n", err)
+			}
+			if err := exportToCSV(issues, "linear_completed_tickets_"+slug+".csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting CSV: %v\n", err)
+			}
+		}
+	}
+
+	printWorkspaceComparison(results)
+
+	if len(combined) == 0 {
+		fmt.Println("\nNo completed issues found in the specified date range.")
+		return
+	}
+
+	fmt.Println("\n📁 Exporting combined files...")
+	if err := exportToJSON(combined, "linear_completed_tickets.json", cfg); err != nil {
+		fmt.Printf("❌ Error exporting JSON: %v\n", err)
+	}
+	if err := exportToCSV(combined, "linear_completed_tickets.csv", cfg); err != nil {
+		fmt.Printf("❌ Error exporting CSV: %v\n", err)
+	}
+	fmt.Println("\n✨ Done! Check the output files for full details.")
+}
+
+// importBatchSize is the number of issues created per issueBatchCreate mutation
+const importBatchSize = 10
+
+// importRow is one issue to create, read from the import CSV
+type importRow struct {
+	Title       string
+	Description string
+	TeamKey     string
+	Priority    int
+	Estimate    *float64
+	Labels      []string
+}
+
+// readImportCSV reads issues to create from a CSV file. The title and team_key
+// columns are required; description, priority, estimate and labels are optional.
+// Priority is 0-4 or a name (urgent, high, medium, low, none) and labels are
+// comma-separated names.
+func readImportCSV(filename string) ([]importRow, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("%s has no issues to import", filename)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"title", "team_key"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make([]importRow, 0, len(records)-1)
+	for n, record := range records[1:] {
+		line := n + 2
+		row := importRow{
+			Title:       field(record, "title"),
+			Description: field(record, "description"),
+			TeamKey:     strings.ToUpper(field(record, "team_key")),
+		}
+		if row.Title == "" || row.TeamKey == "" {
+			return nil, fmt.Errorf("line %d: title and team_key are required", line)
+		}
+
+		if value := field(record, "priority"); value != "" {
+			if priority, ok := slaPriorities[strings.ToLower(value)]; ok {
+				row.Priority = priority
+			} else if priority, err := strconv.Atoi(value); err == nil && priority >= 0 && priority <= 4 {
+				row.Priority = priority
+			} else {
+				return nil, fmt.Errorf("line %d: invalid priority %q", line, value)
+			}
+		}
+
+		if value := field(record, "estimate"); value != "" {
+			estimate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid estimate %q", line, value)
+			}
+			row.Estimate = &estimate
+		}
+
+		for _, label := range strings.Split(field(record, "labels"), ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				row.Labels = append(row.Labels, label)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// labelKey keys a label by its team and lower-cased name; workspace labels
+// have an empty team key
+func labelKey(teamKey, name string) string {
+	return strings.ToUpper(teamKey) + "/" + strings.ToLower(name)
+}
+
+// getLabelIDs fetches every workspace and team label keyed by labelKey
+func getLabelIDs(apiKey string) (map[string]string, error) {
+	query := `
+	query GetLabels($first: Int!, $after: String) {
+		issueLabels(first: $first, after: $after) {
+			nodes {
+				id
+				name
+				team {
+					key
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+
+	ids := make(map[string]string)
+	var afterCursor *string
+	for {
+		variables := map[string]interface{}{
+			"first": defaultPageSize,
+			"after": afterCursor,
+		}
+		resp, err := makeGraphQLRequest(apiKey, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch labels: %w", err)
+		}
+		for _, label := range resp.Data.IssueLabels.Nodes {
+			teamKey := ""
+			if label.Team != nil {
+				teamKey = label.Team.Key
+			}
+			ids[labelKey(teamKey, label.Name)] = label.ID
+		}
+
+		pageInfo := resp.Data.IssueLabels.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		afterCursor = pageInfo.EndCursor
+	}
+	return ids, nil
+}
+
+// resolveLabelID returns the ID of the named label in the team, falling back to
+// a workspace label of that name
+func resolveLabelID(labelIDs map[string]string, teamKey, name string) (string, bool) {
+	if id, ok := labelIDs[labelKey(teamKey, name)]; ok {
+		return id, true
+	}
+	id, ok := labelIDs[labelKey("", name)]
+	return id, ok
+}
+
+// printProgress redraws a single-line progress bar
+func printProgress(done, total int) {
+	const width = 30
+	filled := done * width / total
+	fmt.Printf("\r[%s%s] %d/%d", strings.Repeat("#", filled), strings.Repeat(".", width-filled), done, total)
+	if done == total {
+		fmt.Println()
+	}
+}
+
+// exportCreatedIssuesToCSV exports the identifiers of imported issues to a CSV file
+func exportCreatedIssuesToCSV(issues []Issue, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Identifier", "Title", "URL"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, issue := range issues {
+		if err := writer.Write([]string{issue.Identifier, issue.Title, issue.URL}); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported %d created issues to %s\n", len(issues), filename)
+	return nil
+}
+
+// runImport creates Linear issues from a CSV file in batches of importBatchSize
+func runImport(apiKey string, args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	filename := fs.String("file", "", "CSV file with title, description, team_key, priority, estimate and labels columns")
+	fs.Parse(args)
+
+	if *filename == "" {
+		fmt.Println("❌ Error: import requires --file")
+		os.Exit(1)
+	}
+
+	rows, err := readImportCSV(*filename)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	teams, err := getTeams(apiKey)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	teamIDs := make(map[string]string, len(teams))
+	for _, team := range teams {
+		teamIDs[strings.ToUpper(team.Key)] = team.ID
+	}
+
+	labelIDs, err := getLabelIDs(apiKey)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Resolve every team and label before creating anything
+	inputs := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		teamID, ok := teamIDs[row.TeamKey]
+		if !ok {
+			fmt.Printf("❌ Error: line %d: unknown team key %q\n", i+2, row.TeamKey)
+			printAvailableTeams(teams)
+			os.Exit(1)
+		}
+		input := map[string]interface{}{
+			"title":    row.Title,
+			"teamId":   teamID,
+			"priority": row.Priority,
+		}
+		if row.Description != "" {
+			input["description"] = row.Description
+		}
+		if row.Estimate != nil {
+			input["estimate"] = *row.Estimate
+		}
+		if len(row.Labels) > 0 {
+			ids := make([]string, len(row.Labels))
+			for j, name := range row.Labels {
+				id, ok := resolveLabelID(labelIDs, row.TeamKey, name)
+				if !ok {
+					fmt.Printf("❌ Error: line %d: unknown label %q in team %s\n", i+2, name, row.TeamKey)
+					os.Exit(1)
+				}
+				ids[j] = id
+			}
+			input["labelIds"] = ids
+		}
+		inputs[i] = input
+	}
+
+	mutation := `
+	mutation ImportIssues($issues: [IssueCreateInput!]!) {
+		issueBatchCreate(input: { issues: $issues }) {
+			success
+			issues {
+				id
+				identifier
+				title
+				url
+			}
 		}
+	}
+	`
 
-		cycle := "N/A"
-		if issue.Cycle != nil {
-			cycle = issue.Cycle.Name
+	fmt.Printf("📥 Creating %d issues from %s\n", len(inputs), *filename)
+	var created []Issue
+	failed := false
+	printProgress(0, len(inputs))
+	for start := 0; start < len(inputs); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(inputs) {
+			end = len(inputs)
 		}
 
-		estimate := "N/A"
-		if issue.Estimate != nil {
-			estimate = fmt.Sprintf("%.0f", *issue.Estimate)
+		resp, err := makeGraphQLRequest(apiKey, mutation, map[string]interface{}{"issues": inputs[start:end]})
+		if err == nil && (resp.Data.IssueBatchCreate == nil || !resp.Data.IssueBatchCreate.Success) {
+			err = fmt.Errorf("issueBatchCreate was not successful")
 		}
-
-		row := []string{
-			issue.Identifier,
-			issue.Title,
-			issue.URL,
-			issue.Team.Name,
-			issue.State.Name,
-			formatPriority(issue.Priority),
-			estimate,
-			labelsStr,
-			project,
-			cycle,
-			formatDateString(issue.CreatedAt),
-			formatDate(issue.CompletedAt),
-			issue.Assignee.Name,
+		if err != nil {
+			fmt.Printf("\n❌ Error creating issues %d-%d: %v\n", start+1, end, err)
+			failed = true
+			break
 		}
+		created = append(created, resp.Data.IssueBatchCreate.Issues...)
+		printProgress(end, len(inputs))
+	}
 
-		if err := writer.Write(row); err != nil {
-			return fmt.Errorf("failed to write CSV row: %w", err)
+	if len(created) > 0 {
+		if err := exportCreatedIssuesToCSV(created, "created_issues.csv"); err != nil {
+			fmt.Printf("❌ Error exporting created issues CSV: %v\n", err)
 		}
 	}
+	if failed {
+		fmt.Printf("Created %d of %d issues before the error\n", len(created), len(inputs))
+		os.Exit(1)
+	}
+}
 
-	fmt.Printf("✅ Exported %d issues to %s\n", len(issues), filename)
-	return nil
+// archiveBatchSize is the number of issueArchive mutations sent per request
+const archiveBatchSize = 50
+
+// archiveMutation builds one request that archives n issues, with each
+// issueArchive aliased a0, a1, ... and taking its ID from $id0, $id1, ...
+func archiveMutation(n int) string {
+	var params, fields strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			params.WriteString(", ")
+		}
+		fmt.Fprintf(&params, "$id%d: String!", i)
+		fmt.Fprintf(&fields, "\t\ta%d: issueArchive(id: $id%d) {\n\t\t\tsuccess\n\t\t}\n", i, i)
+	}
+	return fmt.Sprintf("mutation ArchiveIssues(%s) {\n%s}", params.String(), fields.String())
 }
 
-// printSummary prints a summary of the issues
-func printSummary(issues []Issue) {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("SUMMARY")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Printf("Total completed issues: %d\n", len(issues))
-	fmt.Println("Date range: January 2025 - February 2026")
+// runArchive archives every issue in a JSON export, in batches of
+// archiveBatchSize with a pause between requests
+func runArchive(apiKey string, args []string) {
+	fs := flag.NewFlagSet("archive", flag.ExitOnError)
+	filename := fs.String("file", "", "JSON export whose issues should be archived")
+	confirmed := fs.Bool("confirmed", false, "archive the issues (required unless --dry-run is set)")
+	dryRun := fs.Bool("dry-run", false, "list the issues that would be archived without archiving them")
+	delay := fs.Duration("delay", time.Second, "pause between batches of 50 mutations")
+	fs.Parse(args)
 
-	if len(issues) > 0 {
-		// Group by team
-		teams := make(map[string]int)
-		for _, issue := range issues {
-			teams[issue.Team.Name]++
+	if *filename == "" {
+		fmt.Println("❌ Error: archive requires --file")
+		os.Exit(1)
+	}
+	if !*confirmed && !*dryRun {
+		fmt.Println("❌ Error: archive changes issues in Linear; pass --confirmed to proceed or --dry-run to preview")
+		os.Exit(1)
+	}
+
+	issues, err := readIssuesJSON(*filename)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	var targets []compactIssue
+	for _, issue := range issues {
+		if issue.Identifier != "" {
+			targets = append(targets, issue)
 		}
+	}
+	if len(targets) == 0 {
+		fmt.Printf("No issues found in %s\n", *filename)
+		return
+	}
 
-		fmt.Println("\nIssues by team:")
-		for team, count := range teams {
-			fmt.Printf("  %s: %d\n", team, count)
+	if *dryRun {
+		fmt.Printf("Would archive %d issues from %s:\n", len(targets), *filename)
+		for _, issue := range targets {
+			fmt.Printf("  %s: %s\n", issue.Identifier, issue.Title)
 		}
+		return
+	}
 
-		// Group by priority
-		priorities := make(map[string]int)
-		for _, issue := range issues {
-			priority := formatPriority(issue.Priority)
-			priorities[priority]++
+	ids := make([]string, len(targets))
+	for i, issue := range targets {
+		ids[i] = issue.Identifier
+	}
+
+	fmt.Printf("🗄️  Archiving %d issues from %s\n", len(ids), *filename)
+	archived := 0
+	printProgress(0, len(ids))
+	for start := 0; start < len(ids); start += archiveBatchSize {
+		if start > 0 {
+			time.Sleep(*delay)
+		}
+		end := start + archiveBatchSize
+		if end > len(ids) {
+			end = len(ids)
 		}
 
-		fmt.Println("\nIssues by priority:")
-		for priority, count := range priorities {
-			fmt.Printf("  %s: %d\n", priority, count)
+		variables := make(map[string]interface{}, end-start)
+		for i, id := range ids[start:end] {
+			variables[fmt.Sprintf("id%d", i)] = id
 		}
+		if _, err := makeGraphQLRequest(apiKey, archiveMutation(end-start), variables); err != nil {
+			fmt.Printf("\n❌ Error archiving issues %d-%d: %v\n", start+1, end, err)
+			fmt.Printf("Archived %d of %d issues before the error\n", archived, len(ids))
+			os.Exit(1)
+		}
+		archived = end
+		printProgress(end, len(ids))
 	}
+	fmt.Printf("✅ Archived %d of %d issues\n", archived, len(ids))
+}
 
-	fmt.Println(strings.Repeat("=", 60))
+// requireAPIKey returns LINEAR_API_KEY, exiting with setup instructions if it is unset
+func requireAPIKey() string {
+	apiKey := os.Getenv("LINEAR_API_KEY")
+	if apiKey == "" {
+		fmt.Println("\n❌ Error: LINEAR_API_KEY environment variable not set!")
+		fmt.Println("\nTo set your API key:")
+		fmt.Println("  1. Go to Linear Settings > API > Personal API Keys")
+		fmt.Println("  2. Create a new API key")
+		fmt.Println("  3. Set it as an environment variable:")
+		fmt.Println("     export LINEAR_API_KEY='your_api_key_here'")
+		os.Exit(1)
+	}
+	return apiKey
 }
 
-// printIssuesTable prints issues in a formatted table
-func printIssuesTable(issues []Issue) {
-	if len(issues) == 0 {
-		fmt.Println("\nNo issues found.")
-		return
+// parseFlags parses command-line flags into a Config
+func parseFlags() *Config {
+	cfg := &Config{PeriodStart: startDate, PeriodEnd: endDate}
+	flag.BoolVar(&cfg.Preview, "preview", false, "fetch only the first page and print a sample of each export format without writing files")
+	flag.BoolVar(&cfg.NoPagination, "no-pagination", false, "make a single request for the first 5 issues, as a credentials and field-mapping smoke test")
+	flag.Var(&cfg.TeamKeys, "team", "only include issues from the team with this key (repeatable)")
+	flag.BoolVar(&cfg.Weekly, "weekly", false, "print a weekly digest and export weekly velocity")
+	weekStart := flag.String("week-start", "monday", "first day of the week for the weekly digest: monday (ISO) or sunday")
+	flag.BoolVar(&cfg.Interactive, "interactive", false, "browse issues in a scrollable terminal view (falls back to the static table when not a TTY)")
+	flag.BoolVar(&cfg.ValidateSchema, "validate-schema", false, "check the issues query against the Linear schema (cached for 24h) before fetching")
+	flag.BoolVar(&cfg.NoCharts, "no-charts", false, "leave the ASCII charts (state types, cycle trend) out of the summary")
+	flag.BoolVar(&cfg.NoColor, "no-color", os.Getenv("NO_COLOR") != "", "disable ANSI colours in terminal output (also set by NO_COLOR)")
+	flag.StringVar(&cfg.LinearAPIURL, "linear-api-url", defaultLinearAPIURL, "Linear GraphQL endpoint, for proxies and test environments (must be HTTPS)")
+	flag.BoolVar(&cfg.LinearInsecure, "linear-insecure", false, "skip TLS certificate verification for the Linear endpoint (test environments only)")
+	flag.StringVar(&cfg.LinearKeyFile, "linear-key-file", "", "JSON file of {\"name\", \"key\"} entries; run against each workspace and combine the results")
+	flag.StringVar(&cfg.SlackChannel, "slack-channel", "", "post each issue to this Slack channel (requires SLACK_BOT_TOKEN)")
+	flag.IntVar(&cfg.SlackBatchSize, "slack-batch-size", 0, "post a summary message to --slack-channel with issues threaded beneath it, this many per reply (at most 16)")
+	flag.BoolVar(&cfg.StateDurations, "state-durations", false, "compute hours spent in each workflow state and export state_durations.csv")
+	flag.BoolVar(&cfg.TrackReassignments, "track-reassignments", false, "report the issues that changed assignee most often")
+	flag.StringVar(&cfg.ICSOut, "ics-out", "", "write an iCalendar file with one event per issue, from creation to completion, to this file")
+	flag.BoolVar(&cfg.ShowTeamBreakdown, "show-team-breakdown", false, "also fetch everyone's completions in your teams and chart them per member")
+	flag.BoolVar(&cfg.CustomFields, "custom-fields", false, "fetch issue custom field values and export them as customFields and extra CSV columns (workspaces with custom fields only)")
+	flag.BoolVar(&cfg.NoUrgentIssues, "no-urgent-issues", false, "skip fetching your open issues for the most urgent issues list in the summary")
+	flag.BoolVar(&cfg.ProjectCompletion, "project-completion", false, "fetch each touched project's issue totals, print a completion table and export project_completion.csv")
+	flag.BoolVar(&cfg.CycleAnalysis, "cycle-analysis", false, "fetch your teams' cycles to report issues that spanned cycles and each issue's share of its cycle")
+	flag.BoolVar(&cfg.DetectReestimates, "detect-reestimates", false, "report issues whose estimate was changed and export them to reestimated_issues.csv")
+	flag.Float64Var(&cfg.VelocityRatio, "velocity-ratio", 0, "points completed per cycle; enables the estimate accuracy report and estimate_accuracy.csv")
+	flag.Float64Var(&cfg.CycleDays, "cycle-days", 14, "cycle length in days used to turn --velocity-ratio into days per point")
+	flag.BoolVar(&cfg.FindMentions, "find-mentions", false, "list issues not assigned to you with a comment mentioning you in the date range; exports mentions.csv")
+	flag.StringVar(&cfg.TagAs, "tag-as", "", "after exporting, add this label to every fetched issue (created if missing)")
+	flag.BoolVar(&cfg.DryRunMutations, "dry-run-mutations", false, "print the --tag-as mutations instead of sending them")
+	flag.BoolVar(&cfg.ShowOverdueOnly, "show-overdue-only", false, "only show issues completed after their due date in the terminal table")
+	flag.IntVar(&cfg.LabelDepth, "label-depth", 0, "number of label hierarchy levels to export: 1 for top-level groups only, 0 for full parent/child paths")
+	flag.StringVar(&cfg.FilterSubscriber, "filter-subscriber", "", "only include issues this email address is subscribed to")
+	flag.IntVar(&cfg.MinSubscribers, "min-subscribers", 0, "only include issues with at least this many subscribers")
+	flag.IntVar(&cfg.MinDescriptionWords, "min-description-words", 0, "only include issues whose description has at least this many words")
+	flag.BoolVar(&cfg.ComparePreviousPeriod, "compare-previous-period", false, "also fetch the equal-length period just before the date range and report the deltas")
+	flag.StringVar(&cfg.SortBy, "sort", "", "order of the terminal table: urgency (priority weighted by age), blocks (issues blocked, most first) or impact (impact score); default is completion order")
+	var templates stringSliceFlag
+	flag.Var(&templates, "description-template", "regular expression every description must match, e.g. '(?m)^## Problem' (repeatable)")
+	impact := flag.String("impact-weights", "", "impact score weights, e.g. estimate=2,subscribers=0.5,blocks=3 (the defaults)")
+	sla := flag.String("sla", "", "completion SLAs per priority, e.g. urgent=3d,high=7d,medium=14d,low=30d; exports sla_report.csv")
+	fields := flag.String("fields", "", "comma-separated compactIssue JSON field names to export as CSV columns, in order")
+	timezone := flag.String("timezone", "Local", "IANA time zone used to bucket completion dates into weeks")
+	flag.Parse()
+
+	if cfg.SlackBatchSize > maxSlackIssuesPerMessage {
+		fmt.Printf("❌ Error: --slack-batch-size must be at most %d (Slack's block limit per message)\n", maxSlackIssuesPerMessage)
+		os.Exit(1)
 	}
 
-	fmt.Println("\n" + strings.Repeat("=", 120))
-	fmt.Printf("%-15s %-50s %-20s %-20s\n", "ID", "Title", "Team", "Completed")
-	fmt.Println(strings.Repeat("=", 120))
+	if cfg.NoColor {
+		colorOutput = false
+	}
 
-	for _, issue := range issues {
-		identifier := issue.Identifier
-		if len(identifier) > 15 {
-			identifier = identifier[:15]
+	if u, err := url.Parse(cfg.LinearAPIURL); err != nil || u.Scheme != "https" || u.Host == "" {
+		fmt.Printf("❌ Error: invalid --linear-api-url %q (must be an https:// URL)\n", cfg.LinearAPIURL)
+		os.Exit(1)
+	}
+	linearAPIURL = cfg.LinearAPIURL
+
+	if cfg.LinearInsecure {
+		fmt.Println(strings.Repeat("!", 60))
+		fmt.Println("⚠️  WARNING: --linear-insecure disables TLS certificate verification.")
+		fmt.Println("⚠️  Your API key can be intercepted. Use only against test environments.")
+		fmt.Println(strings.Repeat("!", 60))
+		linearClient = &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
 		}
+	}
+
+	switch strings.ToLower(*weekStart) {
+	case "monday":
+		cfg.WeekStart = time.Monday
+	case "sunday":
+		cfg.WeekStart = time.Sunday
+	default:
+		fmt.Printf("❌ Error: invalid --week-start %q (use monday or sunday)\n", *weekStart)
+		os.Exit(1)
+	}
+
+	loc, err := time.LoadLocation(*timezone)
+	if err != nil {
+		fmt.Printf("❌ Error: invalid --timezone %q: %v\n", *timezone, err)
+		os.Exit(1)
+	}
+	cfg.Location = loc
+
+	if cfg.LabelDepth < 0 {
+		fmt.Println("❌ Error: --label-depth must not be negative")
+		os.Exit(1)
+	}
 
-		title := issue.Title
-		if len(title) > 50 {
-			title = title[:47] + "..."
+	if cfg.VelocityRatio < 0 || cfg.CycleDays <= 0 {
+		fmt.Println("❌ Error: --velocity-ratio must not be negative and --cycle-days must be positive")
+		os.Exit(1)
+	}
+
+	switch cfg.SortBy {
+	case "", "urgency", "blocks", "impact":
+	default:
+		fmt.Printf("❌ Error: invalid --sort %q (use urgency, blocks or impact)\n", cfg.SortBy)
+		os.Exit(1)
+	}
+
+	for _, pattern := range templates {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --description-template %q: %v\n", pattern, err)
+			os.Exit(1)
 		}
+		cfg.DescriptionTemplates = append(cfg.DescriptionTemplates, re)
+	}
 
-		team := issue.Team.Name
-		if len(team) > 20 {
-			team = team[:20]
+	cfg.ImpactWeights = defaultImpactWeights
+	if *impact != "" {
+		parsed, err := parseImpactWeights(*impact)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --impact-weights: %v\n", err)
+			os.Exit(1)
 		}
+		cfg.ImpactWeights = parsed
+	}
 
-		completed := formatDate(issue.CompletedAt)
-		if len(completed) > 20 {
-			completed = completed[:20]
+	if *sla != "" {
+		parsed, err := parseSLA(*sla)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --sla: %v\n", err)
+			os.Exit(1)
 		}
+		cfg.SLA = parsed
+	}
 
-		fmt.Printf("%-15s %-50s %-20s %-20s\n", identifier, title, team, completed)
+	if *fields != "" {
+		parsed, err := parseFields(*fields)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --fields: %v\n", err)
+			os.Exit(1)
+		}
+		cfg.Fields = parsed
 	}
 
-	fmt.Println(strings.Repeat("=", 120))
+	return cfg
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			runImport(requireAPIKey(), os.Args[2:])
+			return
+		case "teams":
+			runTeams(requireAPIKey())
+			return
+		case "projects":
+			runProjects(requireAPIKey())
+			return
+		case "cycles":
+			runCycles(requireAPIKey())
+			return
+		case "diff-descriptions":
+			runDiffDescriptions(os.Args[2:])
+			return
+		case "webhook-server":
+			runWebhookServer(os.Args[2:])
+			return
+		case "clean":
+			runClean(os.Args[2:])
+			return
+		case "archive":
+			runArchive(requireAPIKey(), os.Args[2:])
+			return
+		}
+	}
+
+	cfg := parseFlags()
+
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Println("Linear Completed Tickets Extractor")
 	fmt.Println(strings.Repeat("=", 60))
 
-	// Check for API key
-	apiKey := os.Getenv("LINEAR_API_KEY")
-	if apiKey == "" {
-		fmt.Println("\n❌ Error: LINEAR_API_KEY environment variable not set!")
-		fmt.Println("\nTo set your API key:")
-		fmt.Println("  1. Go to Linear Settings > API > Personal API Keys")
-		fmt.Println("  2. Create a new API key")
-		fmt.Println("  3. Set it as an environment variable:")
-		fmt.Println("     export LINEAR_API_KEY='your_api_key_here'")
+	if cfg.LinearKeyFile != "" {
+		fmt.Printf("\n📅 Searching for completed tickets from %s to %s\n", startDate, endDate)
+		runWorkspaces(cfg)
+		return
+	}
+
+	apiKey := requireAPIKey()
+
+	slackToken := os.Getenv("SLACK_BOT_TOKEN")
+	if cfg.SlackChannel != "" && slackToken == "" {
+		fmt.Println("\n❌ Error: --slack-channel requires the SLACK_BOT_TOKEN environment variable")
 		os.Exit(1)
 	}
 
+	if cfg.ValidateSchema {
+		schema, err := getSchema(apiKey)
+		if err != nil {
+			fmt.Printf("\n❌ Error: %v\n", err)
+			os.Exit(1)
+		}
+		if missing := validateQuery(schema, issuesQuery(cfg)); len(missing) > 0 {
+			fmt.Println("\n❌ Error: the issues query uses fields that are not in the Linear schema:")
+			for _, m := range missing {
+				fmt.Printf("  - %s\n", m)
+			}
+			os.Exit(1)
+		}
+		fmt.Println("✅ Issues query matches the Linear schema")
+	}
+
+	if len(cfg.TeamKeys) > 0 {
+		if err := validateTeams(apiKey, cfg); err != nil {
+			fmt.Printf("\n❌ Error: %v\n", err)
+			if len(cfg.Teams) > 0 {
+				printAvailableTeams(cfg.Teams)
+			}
+			os.Exit(1)
+		}
+	}
+
 	fmt.Printf("\n📅 Searching for completed tickets from %s to %s\n\n", startDate, endDate)
 
+	// Mentions are looked up in the background while the main fetch runs
+	var mentionsCh chan mentionResult
+	if cfg.FindMentions && !cfg.Preview {
+		mentionsCh = make(chan mentionResult, 1)
+		go func() {
+			issues, err := getMentionedIssues(apiKey, cfg)
+			mentionsCh <- mentionResult{issues: issues, err: err}
+		}()
+	}
+
 	// Fetch issues
-	issues, err := getCompletedIssues(apiKey)
+	issues, err := getCompletedIssues(apiKey, cfg)
 	if err != nil {
 		fmt.Printf("❌ Error fetching issues: %v\n", err)
 		os.Exit(1)
 	}
+	issues = filterIssues(issues, cfg)
+
+	if cfg.ComparePreviousPeriod && !cfg.Preview {
+		prevCfg := *cfg
+		prevStart, prevEnd, err := previousPeriod(cfg.PeriodStart, cfg.PeriodEnd)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			os.Exit(1)
+		}
+		prevCfg.PeriodStart, prevCfg.PeriodEnd = prevStart, prevEnd
+
+		fmt.Printf("\n📅 Fetching the previous period, %s to %s\n", prevStart, prevEnd)
+		prevIssues, err := getCompletedIssues(apiKey, &prevCfg)
+		if err != nil {
+			fmt.Printf("❌ Error fetching previous period: %v\n", err)
+			os.Exit(1)
+		}
+		prevIssues = filterIssues(prevIssues, &prevCfg)
+
+		cfg.Comparison = &periodComparison{
+			Current:  totalsFor(issues, cfg.PeriodStart, cfg.PeriodEnd),
+			Previous: totalsFor(prevIssues, prevStart, prevEnd),
+		}
+	}
+
+	if cfg.CycleAnalysis && !cfg.Preview && len(issues) > 0 {
+		cycles, err := getCycles(apiKey, cycleRangeFilter(issues, cfg))
+		if err != nil {
+			fmt.Printf("❌ Error fetching cycle dates: %v\n", err)
+		}
+		cfg.CycleRanges = cycles
+	}
+
+	if !cfg.NoCharts && !cfg.Preview {
+		counts, err := getStateTypeCounts(apiKey, cfg)
+		if err != nil {
+			fmt.Printf("❌ Error fetching issue states: %v\n", err)
+		}
+		cfg.StateTypeCounts = counts
+	}
+
+	if cfg.ShowTeamBreakdown && !cfg.Preview {
+		if keys := breakdownTeamKeys(issues, cfg); len(keys) > 0 {
+			fmt.Printf("\n👥 Fetching completions for everyone in %s\n", strings.Join(keys, ", "))
+			members, err := getTeamBreakdown(apiKey, cfg, keys)
+			if err != nil {
+				fmt.Printf("❌ Error fetching team breakdown: %v\n", err)
+			}
+			cfg.TeamBreakdown = members
+		}
+	}
+
+	if cfg.Preview {
+		if err := printPreview(issues, cfg); err != nil {
+			fmt.Printf("❌ Error printing preview: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var openIssues []Issue
+	if !cfg.NoUrgentIssues {
+		openIssues, err = getOpenIssues(apiKey, cfg)
+		if err != nil {
+			fmt.Printf("❌ Error fetching open issues: %v\n", err)
+		}
+	}
 
 	// Print results
-	printIssuesTable(issues)
-	printSummary(issues)
+	display := issues
+	switch cfg.SortBy {
+	case "urgency":
+		display = sortedByUrgency(display, time.Now())
+	case "blocks":
+		display = sortedByBlocks(display)
+	case "impact":
+		display = sortedByImpact(display, cfg.ImpactWeights)
+	}
+	if cfg.ShowOverdueOnly {
+		display = overdueIssues(display)
+	}
+	showIssues(display, cfg)
+	printSummary(issues, openIssues, cfg)
+	if cfg.Comparison != nil {
+		printPeriodComparison(cfg.Comparison)
+	}
+
+	var mentions []Issue
+	if mentionsCh != nil {
+		result := <-mentionsCh
+		if result.err != nil {
+			fmt.Printf("❌ Error finding mentions: %v\n", result.err)
+		} else {
+			mentions = result.issues
+			fmt.Printf("\n💬 Issues mentioning you: %d\n", len(mentions))
+			printIssuesTable(mentions)
+		}
+	}
+
+	var projects []projectCompletion
+	if cfg.ProjectCompletion {
+		projects, err = getProjectCompletion(apiKey, issues)
+		if err != nil {
+			fmt.Printf("❌ Error fetching project completion: %v\n", err)
+		} else if len(projects) > 0 {
+			printProjectCompletion(projects)
+		}
+	}
+
+	var weeks []weeklyVelocity
+	if cfg.Weekly {
+		weeks = computeWeeklyVelocity(issues, cfg.Location, cfg.WeekStart)
+		printWeeklyDigest(weeks)
+	}
 
 	// Export to files
 	if len(issues) > 0 {
 		fmt.Println("\n📁 Exporting to files...")
 
-		if err := exportToJSON(issues, "linear_completed_tickets.json"); err != nil {
+		if err := exportToJSON(issues, "linear_completed_tickets.json", cfg); err != nil {
 			fmt.Printf("❌ Error exporting JSON: %v\n", err)
 		}
 
-		if err := exportToCSV(issues, "linear_completed_tickets.csv"); err != nil {
+		if err := exportToCSV(issues, "linear_completed_tickets.csv", cfg); err != nil {
 			fmt.Printf("❌ Error exporting CSV: %v\n", err)
 		}
 
+		if err := exportToMarkdown(issues, "linear_completed_tickets.md"); err != nil {
+			fmt.Printf("❌ Error exporting Markdown: %v\n", err)
+		}
+
+		if cfg.ICSOut != "" {
+			if err := exportToICS(issues, cfg.ICSOut); err != nil {
+				fmt.Printf("❌ Error exporting ICS: %v\n", err)
+			}
+		}
+
+		if len(projects) > 0 {
+			if err := exportProjectCompletionToCSV(projects, "project_completion.csv"); err != nil {
+				fmt.Printf("❌ Error exporting project completion CSV: %v\n", err)
+			}
+		}
+
+		if len(mentions) > 0 {
+			if err := exportToCSV(mentions, "mentions.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting mentions CSV: %v\n", err)
+			}
+		}
+
+		if cfg.Comparison != nil {
+			if err := exportSummaryToCSV(cfg.Comparison, "linear_summary.csv"); err != nil {
+				fmt.Printf("❌ Error exporting summary CSV: %v\n", err)
+			}
+		}
+
+		if cycles := computeCycleTrend(issues); len(cycles) > 0 {
+			if err := exportCycleTrendToCSV(cycles, "cycle_trend.csv"); err != nil {
+				fmt.Printf("❌ Error exporting cycle trend CSV: %v\n", err)
+			}
+		}
+
+		if len(cfg.DescriptionTemplates) > 0 {
+			if err := exportDescriptionViolationsToCSV(issues, cfg, "description_violations.csv"); err != nil {
+				fmt.Printf("❌ Error exporting description violations CSV: %v\n", err)
+			}
+		}
+
+		if cfg.VelocityRatio > 0 {
+			accuracy := estimateAccuracy(issues, cfg.CycleDays, cfg.VelocityRatio)
+			if err := exportEstimateAccuracyToCSV(accuracy, "estimate_accuracy.csv"); err != nil {
+				fmt.Printf("❌ Error exporting estimate accuracy CSV: %v\n", err)
+			}
+		}
+
+		if len(cfg.SLA) > 0 {
+			if err := exportSLAReportToCSV(issues, cfg.SLA, "sla_report.csv"); err != nil {
+				fmt.Printf("❌ Error exporting SLA report CSV: %v\n", err)
+			}
+		}
+
+		if len(cfg.TeamBreakdown) > 0 {
+			if err := exportTeamDistributionToCSV(cfg.TeamBreakdown, "team_distribution.csv"); err != nil {
+				fmt.Printf("❌ Error exporting team distribution CSV: %v\n", err)
+			}
+		}
+
+		if err := exportCommentResolutionToCSV(issues, "comment_resolution_correlation.csv"); err != nil {
+			fmt.Printf("❌ Error exporting comment/resolution correlation CSV: %v\n", err)
+		}
+
+		if rows := computeCycleFit(issues, cfg.CycleRanges); len(rows) > 0 {
+			if err := exportCycleFitToCSV(rows, "cycle_fit_report.csv"); err != nil {
+				fmt.Printf("❌ Error exporting cycle fit CSV: %v\n", err)
+			}
+		}
+
+		if err := exportEstimateDistributionToCSV(computeEstimateDistribution(issues), "estimate_distribution.csv"); err != nil {
+			fmt.Printf("❌ Error exporting estimate distribution CSV: %v\n", err)
+		}
+
+		if overruns := cycleOverruns(issues, cfg.CycleRanges); len(overruns) > 0 {
+			if err := exportCycleOverrunsToCSV(overruns, cfg.CycleRanges, "cycle_overruns.csv"); err != nil {
+				fmt.Printf("❌ Error exporting cycle overruns CSV: %v\n", err)
+			}
+		}
+
+		if reopened := reopenedIssues(issues); len(reopened) > 0 {
+			if err := exportReopenedToCSV(reopened, "reopened_issues.csv"); err != nil {
+				fmt.Printf("❌ Error exporting reopened issues CSV: %v\n", err)
+			}
+		}
+
+		if reestimated := reestimatedIssues(issues); cfg.DetectReestimates && len(reestimated) > 0 {
+			if err := exportReestimatedToCSV(reestimated, "reestimated_issues.csv"); err != nil {
+				fmt.Printf("❌ Error exporting re-estimated issues CSV: %v\n", err)
+			}
+		}
+
+		if cfg.StateDurations {
+			if err := exportStateDurationsToCSV(issues, "state_durations.csv"); err != nil {
+				fmt.Printf("❌ Error exporting state durations CSV: %v\n", err)
+			}
+		}
+
+		if points := forecastVelocity(velocityByWeek(issues, cfg.Location), forecastWeeks); len(points) > 0 {
+			if err := exportForecastToCSV(points, "velocity_forecast.csv"); err != nil {
+				fmt.Printf("❌ Error exporting velocity forecast CSV: %v\n", err)
+			}
+		}
+
+		if cfg.Weekly {
+			if err := exportWeeklyVelocityToCSV(weeks, "linear_weekly_velocity.csv"); err != nil {
+				fmt.Printf("❌ Error exporting weekly velocity CSV: %v\n", err)
+			}
+		}
+
+		if cfg.SlackChannel != "" {
+			fmt.Println("\n📣 Posting to Slack...")
+			if err := exportToSlack(issues, slackToken, cfg); err != nil {
+				fmt.Printf("❌ Error posting to Slack: %v\n", err)
+			}
+		}
+
+		if cfg.TagAs != "" {
+			fmt.Printf("\n🏷️  Tagging issues with %q...\n", cfg.TagAs)
+			if err := tagIssues(apiKey, issues, cfg); err != nil {
+				fmt.Printf("❌ Error tagging issues: %v\n", err)
+			}
+		}
+
 		fmt.Println("\n✨ Done! Check the output files for full details.")
 	} else {
 		fmt.Println("\nNo completed issues found in the specified date range.")
@@ -1,24 +1,152 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 )
 
 const (
 	githubGraphQLURL = "https://api.github.com/graphql"
-	searchQuery      = "is:pr author:@me is:merged merged:2025-01-01..2026-02-28"
+	githubRESTURL    = "https://api.github.com"
+	mergedStartDate  = "2025-01-01"
+	mergedEndDate    = "2026-02-28"
 	startDateDisplay = "January 2025"
 	endDateDisplay   = "February 2026"
+
+	// previewRecordLimit is the number of records printed per format in preview mode
+	previewRecordLimit = 5
+
+	// slackPostMessageURL is Slack's chat.postMessage endpoint; slackPostInterval
+	// keeps posting under the Tier-1 rate limit of one message per second
+	slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+	slackPostInterval   = time.Second
+
+	// maxSlackPRsPerMessage keeps batched messages under Slack's 50-block limit
+	maxSlackPRsPerMessage = 16
+
+	// maxBranchNameLength is where base branch names are cut off in the summary chart;
+	// maxBarWidth is the length of the longest bar in ASCII bar charts
+	maxBranchNameLength = 30
+	maxBarWidth         = 40
+
+	// collaboratorFetchConcurrency bounds the parallel repository queries for --dot-out
+	collaboratorFetchConcurrency = 4
+
+	// defaultOrgReportConcurrency bounds the parallel per-member searches of org-report
+	defaultOrgReportConcurrency = 4
+
+	// defaultPageSize is the number of records requested per page; smokeTestPageSize
+	// is used instead when pagination is disabled
+	defaultPageSize   = 100
+	smokeTestPageSize = 5
 )
 
+// Config holds the runtime options parsed from command-line flags
+type Config struct {
+	Preview      bool
+	NoPagination bool
+	Milestone    bool
+	NoMilestone  bool
+	Org          string
+	OrgStats     bool
+
+	Interactive       bool
+	DetectCoauthors   bool
+	DetectCherryPicks bool
+	LintCommits       bool
+	ProjectBoards     bool
+	WaitOnRateLimit   bool
+	RepoTopics        stringSliceFlag
+	MinApprovals      int
+	MinBodyWords      int
+	BranchPattern     *regexp.Regexp
+	SlackChannel      string
+	SlackBatchSize    int
+	DotOut            string
+	ExcludeForks      bool
+	ExcludeArchived   bool
+	EnrichTeams       bool
+	ExcludeReopened   bool
+	ContributorRank   bool
+	RequireLinked     bool
+	ExcludeFailing    bool
+	ExcludeReverts    bool
+	OnlyReverts       bool
+	ReviewedBy        string
+	ProtectedOnly     bool
+	Location          *time.Location
+	FlagBypasses      bool
+	FlagSelfMerges    bool
+	DeployBranch      string
+	GitHubActions     bool
+	DraftConverted    bool
+	ExcludeConflicts  bool
+	Topic             string
+	MentionFilter     string
+	BranchDivergence  bool
+
+	// TopicPatterns classify PRs into topics, first match wins
+	TopicPatterns []topicPattern
+
+	// AuthorTeams maps author logins to their --org team names when --enrich-teams is set
+	AuthorTeams map[string][]string
+
+	// Divergence maps "owner/repo#number" to the commits the base branch was ahead
+	// of the PR's head when --branch-divergence is set
+	Divergence map[string]int
+
+	// Workflows maps "owner/repo#number" to the PR's GitHub Actions result when --github-actions is set
+	Workflows map[string]workflowResult
+
+	// Fields lists the compactPR JSON tags to export as CSV columns, in order
+	Fields []string
+}
+
+// pageSize returns the number of records to request per page
+func (c *Config) pageSize() int {
+	if c.NoPagination {
+		return smokeTestPageSize
+	}
+	return defaultPageSize
+}
+
+// fetchesCommits reports whether a flag needs each PR's commits, which the
+// query otherwise leaves out
+func (c *Config) fetchesCommits() bool {
+	return c.DetectCoauthors || c.LintCommits || c.DetectCherryPicks || c.ExcludeConflicts
+}
+
+// stringSliceFlag is a flag.Value that collects repeated string flags
+type stringSliceFlag []string
+
+func (f *stringSliceFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringSliceFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 // GraphQL request/response types
 
 type GraphQLRequest struct {
@@ -37,7 +165,43 @@ type GraphQLError struct {
 }
 
 type Data struct {
-	Search SearchResult `json:"search"`
+	Viewer       Viewer            `json:"viewer"`
+	Organization *Organization     `json:"organization"`
+	Search       SearchResult      `json:"search"`
+	Repository   *RepositoryDetail `json:"repository"`
+	RateLimit    *RateLimit        `json:"rateLimit"`
+}
+
+type RateLimit struct {
+	Cost      int    `json:"cost"`
+	Limit     int    `json:"limit"`
+	Remaining int    `json:"remaining"`
+	ResetAt   string `json:"resetAt"`
+}
+
+type Viewer struct {
+	Login         string                 `json:"login"`
+	Organizations OrganizationConnection `json:"organizations"`
+}
+
+type OrganizationConnection struct {
+	Nodes []Organization `json:"nodes"`
+}
+
+type Organization struct {
+	Login           string          `json:"login"`
+	Name            string          `json:"name"`
+	Teams           TeamConnection  `json:"teams"`
+	MembersWithRole ActorConnection `json:"membersWithRole"`
+}
+
+type TeamConnection struct {
+	Nodes []Team `json:"nodes"`
+}
+
+type Team struct {
+	Name    string          `json:"name"`
+	Members ActorConnection `json:"members"`
 }
 
 type SearchResult struct {
@@ -57,49 +221,284 @@ type PageInfo struct {
 }
 
 type PullRequest struct {
-	Number       int        `json:"number"`
-	Title        string     `json:"title"`
-	URL          string     `json:"url"`
-	Body         string     `json:"body"`
-	State        string     `json:"state"`
-	MergedAt     *string    `json:"mergedAt"`
-	CreatedAt    string     `json:"createdAt"`
-	UpdatedAt    string     `json:"updatedAt"`
-	Additions    int        `json:"additions"`
-	Deletions    int        `json:"deletions"`
-	ChangedFiles int        `json:"changedFiles"`
-	HeadRefName  string     `json:"headRefName"`
-	Repository   Repository `json:"repository"`
-	Reviews      CountNode  `json:"reviews"`
-	Comments     CountNode  `json:"comments"`
-	Labels       Labels     `json:"labels"`
+	Number           int               `json:"number"`
+	Title            string            `json:"title"`
+	URL              string            `json:"url"`
+	Body             string            `json:"body"`
+	State            string            `json:"state"`
+	MergedAt         *string           `json:"mergedAt"`
+	CreatedAt        string            `json:"createdAt"`
+	UpdatedAt        string            `json:"updatedAt"`
+	Additions        int               `json:"additions"`
+	Deletions        int               `json:"deletions"`
+	ChangedFiles     int               `json:"changedFiles"`
+	HeadRefName      string            `json:"headRefName"`
+	HeadRefOid       string            `json:"headRefOid"`
+	BaseRefOid       string            `json:"baseRefOid"`
+	BaseRefName      string            `json:"baseRefName"`
+	BaseRef          *BaseRef          `json:"baseRef"`
+	Mergeable        string            `json:"mergeable"`
+	MergeCommit      *MergeCommit      `json:"mergeCommit"`
+	AutoMergeRequest *AutoMergeRequest `json:"autoMergeRequest"`
+	AutoMergeEnabled EventTimestamps   `json:"autoMergeEnabled"`
+	Author           Actor             `json:"author"`
+	MergedBy         *Actor            `json:"mergedBy"`
+	Assignees        Assignees         `json:"assignees"`
+	ProjectItems     ProjectItems      `json:"projectItems"`
+	Repository       Repository        `json:"repository"`
+	Reviews          ReviewData        `json:"reviews"`
+	Comments         CountNode         `json:"comments"`
+	ReviewRequests   ReviewRequests    `json:"reviewRequests"`
+	Labels           Labels            `json:"labels"`
+	Milestone        *PRMilestone      `json:"milestone"`
+	Commits          PRCommits         `json:"commits"`
+	FirstApproval    ReviewTimestamps  `json:"firstApproval"`
+	CloseEvents      TimelineItems     `json:"closeEvents"`
+	ReadyForReview   EventTimestamps   `json:"readyForReview"`
+	ReviewComments   ReviewComments    `json:"reviewComments"`
+	Files            PathNodes         `json:"files"`
+	ReviewThreads    PathNodes         `json:"reviewThreads"`
+	LastCommit       PRCommits         `json:"lastCommit"`
+}
+
+// BaseRef is nil when the base branch has since been deleted
+type BaseRef struct {
+	BranchProtectionRule *struct {
+		ID string `json:"id"`
+	} `json:"branchProtectionRule"`
+}
+
+type TimelineItems struct {
+	Nodes []TimelineItem `json:"nodes"`
+}
+
+type TimelineItem struct {
+	Typename string `json:"__typename"`
 }
 
 type Repository struct {
+	Name             string           `json:"name"`
+	Owner            RepositoryOwner  `json:"owner"`
+	IsFork           bool             `json:"isFork"`
+	IsArchived       bool             `json:"isArchived"`
+	Parent           *ParentRepo      `json:"parent"`
+	RepositoryTopics RepositoryTopics `json:"repositoryTopics"`
+}
+
+type ParentRepo struct {
 	Name  string          `json:"name"`
 	Owner RepositoryOwner `json:"owner"`
 }
 
+type RepositoryDetail struct {
+	Collaborators    ActorConnection `json:"collaborators"`
+	MentionableUsers CountNode       `json:"mentionableUsers"`
+}
+
+type ActorConnection struct {
+	Nodes    []Actor  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+type RepositoryTopics struct {
+	Nodes []RepositoryTopic `json:"nodes"`
+}
+
+type RepositoryTopic struct {
+	Topic Topic `json:"topic"`
+}
+
+type Topic struct {
+	Name string `json:"name"`
+}
+
 type RepositoryOwner struct {
 	Login string `json:"login"`
 }
 
+type Actor struct {
+	Login string `json:"login"`
+}
+
+// MergeCommit is nil for PRs that haven't been merged
+type MergeCommit struct {
+	Oid     string        `json:"oid"`
+	Message string        `json:"message"`
+	Parents CommitParents `json:"parents"`
+}
+
+type CommitParents struct {
+	TotalCount int `json:"totalCount"`
+	Nodes      []struct {
+		Oid string `json:"oid"`
+	} `json:"nodes"`
+}
+
+// AutoMergeRequest is only set while auto-merge is pending; GitHub clears it
+// once the PR merges, so merged PRs rely on the AutoMergeEnabled timeline event
+type AutoMergeRequest struct {
+	EnabledBy   Actor  `json:"enabledBy"`
+	MergeMethod string `json:"mergeMethod"`
+}
+
+// ReviewData holds a PR's reviews; the per-state counts are tallied after fetching
+type ReviewData struct {
+	TotalCount       int      `json:"totalCount"`
+	Nodes            []Review `json:"nodes"`
+	Approved         int      `json:"-"`
+	ChangesRequested int      `json:"-"`
+	Commented        int      `json:"-"`
+}
+
+type Review struct {
+	State       string `json:"state"`
+	Author      Actor  `json:"author"`
+	SubmittedAt string `json:"submittedAt"`
+}
+
+// tallyStates counts the fetched reviews by state
+func (r *ReviewData) tallyStates() {
+	r.Approved, r.ChangesRequested, r.Commented = 0, 0, 0
+	for _, review := range r.Nodes {
+		switch review.State {
+		case "APPROVED":
+			r.Approved++
+		case "CHANGES_REQUESTED":
+			r.ChangesRequested++
+		case "COMMENTED":
+			r.Commented++
+		}
+	}
+}
+
+// PathNodes holds the paths of changed files or review threads
+type PathNodes struct {
+	Nodes []struct {
+		Path string `json:"path"`
+	} `json:"nodes"`
+}
+
+type ReviewComments struct {
+	Nodes []ReviewWithComments `json:"nodes"`
+}
+
+type ReviewWithComments struct {
+	Comments CommentBodies `json:"comments"`
+}
+
+type CommentBodies struct {
+	Nodes []CommentBody `json:"nodes"`
+}
+
+type CommentBody struct {
+	Body string `json:"body"`
+}
+
+type ReviewTimestamps struct {
+	Nodes []ReviewTimestamp `json:"nodes"`
+}
+
+type ReviewTimestamp struct {
+	SubmittedAt string `json:"submittedAt"`
+}
+
+type EventTimestamps struct {
+	Nodes []struct {
+		CreatedAt string `json:"createdAt"`
+	} `json:"nodes"`
+}
+
 type CountNode struct {
 	TotalCount int `json:"totalCount"`
 }
 
+type ReviewRequests struct {
+	TotalCount int             `json:"totalCount"`
+	Nodes      []ReviewRequest `json:"nodes"`
+}
+
+type ReviewRequest struct {
+	// RequestedReviewer is empty for team requests, which only users are selected from
+	RequestedReviewer Actor `json:"requestedReviewer"`
+}
+
+type ProjectItems struct {
+	Nodes []ProjectItem `json:"nodes"`
+}
+
+type ProjectItem struct {
+	Project struct {
+		Title string `json:"title"`
+	} `json:"project"`
+	// Status is nil when the board has no single-select Status field
+	Status *struct {
+		Name string `json:"name"`
+	} `json:"status"`
+}
+
+type Assignees struct {
+	Nodes []User `json:"nodes"`
+}
+
+type User struct {
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type PRMilestone struct {
+	Number int     `json:"number"`
+	Title  string  `json:"title"`
+	DueOn  *string `json:"dueOn"`
+}
+
+type PRCommits struct {
+	Nodes []PRCommit `json:"nodes"`
+}
+
+type PRCommit struct {
+	Commit Commit `json:"commit"`
+}
+
+type Commit struct {
+	Oid               string             `json:"oid"`
+	Message           string             `json:"message"`
+	Parents           CommitParents      `json:"parents"`
+	StatusCheckRollup *StatusCheckRollup `json:"statusCheckRollup"`
+	CheckSuites       CheckSuites        `json:"checkSuites"`
+}
+
+type CheckSuites struct {
+	Nodes []CheckSuite `json:"nodes"`
+}
+
+type CheckSuite struct {
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+type StatusCheckRollup struct {
+	State string `json:"state"`
+}
+
 type Labels struct {
 	Nodes []Label `json:"nodes"`
 }
 
 type Label struct {
-	Name string `json:"name"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
 }
 
 // GraphQL query for fetching merged pull requests
 
 const mergedPRsQuery = `
-query GetMergedPRs($queryString: String!, $first: Int!, $after: String) {
+query GetMergedPRs($queryString: String!, $first: Int!, $after: String, $withCommits: Boolean!, $withProjects: Boolean!) {
+	rateLimit {
+		cost
+		limit
+		remaining
+		resetAt
+	}
 	search(query: $queryString, type: ISSUE, first: $first, after: $after) {
 		issueCount
 		edges {
@@ -117,21 +516,180 @@ query GetMergedPRs($queryString: String!, $first: Int!, $after: String) {
 					deletions
 					changedFiles
 					headRefName
+					headRefOid
+					baseRefOid
+					baseRefName
+					baseRef {
+						branchProtectionRule {
+							id
+						}
+					}
+					mergeable
+					mergeCommit {
+						oid
+						message
+						parents(first: 2) {
+							totalCount
+							nodes {
+								oid
+							}
+						}
+					}
+					author {
+						login
+					}
+					mergedBy {
+						login
+					}
+					autoMergeRequest {
+						enabledBy {
+							login
+						}
+						mergeMethod
+					}
 					repository {
 						name
 						owner {
 							login
 						}
+						isFork
+						isArchived
+						parent {
+							name
+							owner {
+								login
+							}
+						}
+						repositoryTopics(first: 10) {
+							nodes {
+								topic {
+									name
+								}
+							}
+						}
 					}
-					reviews {
+					reviews(first: 100) {
 						totalCount
+						nodes {
+							state
+							author {
+								login
+							}
+							submittedAt
+						}
+					}
+					reviewComments: reviews(first: 20) {
+						nodes {
+							comments(first: 20) {
+								nodes {
+									body
+								}
+							}
+						}
+					}
+					files(first: 100) {
+						nodes {
+							path
+						}
+					}
+					reviewThreads(first: 100) {
+						nodes {
+							path
+						}
+					}
+					firstApproval: reviews(first: 1, states: [APPROVED]) {
+						nodes {
+							submittedAt
+						}
+					}
+					autoMergeEnabled: timelineItems(itemTypes: [AUTO_MERGE_ENABLED_EVENT], first: 1) {
+						nodes {
+							... on AutoMergeEnabledEvent {
+								createdAt
+							}
+						}
+					}
+					readyForReview: timelineItems(itemTypes: [READY_FOR_REVIEW_EVENT], first: 1) {
+						nodes {
+							... on ReadyForReviewEvent {
+								createdAt
+							}
+						}
+					}
+					closeEvents: timelineItems(itemTypes: [CLOSED_EVENT, REOPENED_EVENT], first: 20) {
+						nodes {
+							__typename
+						}
 					}
 					comments {
 						totalCount
 					}
+					reviewRequests(first: 20) {
+						totalCount
+						nodes {
+							requestedReviewer {
+								... on User {
+									login
+								}
+							}
+						}
+					}
+					assignees(first: 5) {
+						nodes {
+							login
+							name
+						}
+					}
+					projectItems(first: 5) @include(if: $withProjects) {
+						nodes {
+							project {
+								title
+							}
+							status: fieldValueByName(name: "Status") {
+								... on ProjectV2ItemFieldSingleSelectValue {
+									name
+								}
+							}
+						}
+					}
 					labels(first: 20) {
 						nodes {
 							name
+							color
+						}
+					}
+					milestone {
+						number
+						title
+						dueOn
+					}
+					commits(first: 100) @include(if: $withCommits) {
+						nodes {
+							commit {
+								oid
+								message
+								parents(first: 2) {
+									totalCount
+									nodes {
+										oid
+									}
+								}
+							}
+						}
+					}
+					lastCommit: commits(last: 1) {
+						nodes {
+							commit {
+								statusCheckRollup {
+									state
+								}
+								checkSuites(first: 5) {
+									nodes {
+										status
+										conclusion
+									}
+								}
+							}
 						}
 					}
 				}
@@ -195,64 +753,460 @@ func makeGraphQLRequest(token string, query string, variables map[string]interfa
 	return &graphQLResp, nil
 }
 
-// getMergedPullRequests fetches all merged PRs using cursor-based pagination
-func getMergedPullRequests(token string) ([]PullRequest, error) {
-	var allPRs []PullRequest
-	var afterCursor *string
+// rateLimitWarnThreshold is the fraction of the hourly GraphQL budget that triggers a warning
+const rateLimitWarnThreshold = 0.8
 
-	fmt.Println("Fetching merged pull requests...")
+// rateLimitMinRemaining is the fraction of the GraphQL budget below which the
+// ratelimit subcommand exits with an error
+const rateLimitMinRemaining = 0.2
 
-	for {
-		variables := map[string]interface{}{
-			"queryString": searchQuery,
-			"first":       100,
-			"after":       afterCursor,
+// getRESTRateLimit reads the REST API quota from the X-RateLimit-* headers of a
+// HEAD request, which does not count against it
+func getRESTRateLimit(token string) (http.Header, error) {
+	req, err := http.NewRequest("HEAD", githubRESTURL+"/rate_limit", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", "pull-requests-extractor")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("REST rate limit request failed with status %d", resp.StatusCode)
+	}
+	return resp.Header, nil
+}
+
+// restMaxRetries is the number of times a rate-limited REST request is retried
+const restMaxRetries = 3
+
+// restRetryDelay returns how long to wait before retrying a REST response that
+// hit a rate limit: Retry-After when GitHub sends it, otherwise until
+// X-RateLimit-Reset once the quota is exhausted, or a minute for a secondary
+// limit without either header. Other responses report false.
+func restRetryDelay(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		resetUnix, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		if err != nil {
+			return time.Minute, true
 		}
+		return time.Until(time.Unix(resetUnix, 0)) + time.Second, true
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return time.Minute, true
+	}
+	return 0, false
+}
 
-		resp, err := makeGraphQLRequest(token, mergedPRsQuery, variables)
+// githubRESTGet sends an authenticated GET to the GitHub REST API, waiting and
+// retrying when the response says a rate limit was hit
+func githubRESTGet(client *http.Client, token, requestURL string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest("GET", requestURL, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("User-Agent", "pull-requests-extractor")
 
-		for _, edge := range resp.Data.Search.Edges {
-			allPRs = append(allPRs, edge.Node)
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+		delay, limited := restRetryDelay(resp)
+		if !limited || attempt == restMaxRetries {
+			return resp, nil
 		}
+		resp.Body.Close()
+		fmt.Printf("⏳ GitHub REST rate limit hit, retrying in %s\n", delay.Round(time.Second))
+		time.Sleep(delay)
+	}
+}
 
-		fmt.Printf("Fetched %d PRs (total: %d / %d)\n",
-			len(resp.Data.Search.Edges), len(allPRs), resp.Data.Search.IssueCount)
+// maxWorkflowRunPages caps the pages of workflow runs fetched per repository for --github-actions
+const maxWorkflowRunPages = 10
 
-		if !resp.Data.Search.PageInfo.HasNextPage {
+// workflowRun is a GitHub Actions run as returned by the REST API
+type workflowRun struct {
+	HeadSHA      string `json:"head_sha"`
+	Status       string `json:"status"`
+	Conclusion   string `json:"conclusion"`
+	RunStartedAt string `json:"run_started_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// workflowResult summarises the workflow runs on a PR's final head commit
+type workflowResult struct {
+	Conclusion      string
+	DurationMinutes float64
+}
+
+// getWorkflowRuns fetches the pull_request workflow runs of a repository created
+// within the given dates (YYYY-MM-DD), newest first
+func getWorkflowRuns(token, repo, from, to string) ([]workflowRun, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	var runs []workflowRun
+	for page := 1; page <= maxWorkflowRunPages; page++ {
+		params := url.Values{}
+		params.Set("event", "pull_request")
+		params.Set("per_page", "100")
+		params.Set("created", from+".."+to)
+		params.Set("page", strconv.Itoa(page))
+
+		resp, err := githubRESTGet(client, token, githubRESTURL+"/repos/"+repo+"/actions/runs?"+params.Encode())
+		if err != nil {
+			return nil, err
+		}
+		var body struct {
+			WorkflowRuns []workflowRun `json:"workflow_runs"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("workflow runs request for %s failed with status %d", repo, resp.StatusCode)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode workflow runs for %s: %w", repo, err)
+		}
+
+		runs = append(runs, body.WorkflowRuns...)
+		if len(body.WorkflowRuns) < 100 {
 			break
 		}
-		afterCursor = resp.Data.Search.PageInfo.EndCursor
 	}
-
-	return allPRs, nil
+	return runs, nil
 }
 
-// Helper functions
+// summariseWorkflowRuns combines the completed runs on one commit: the conclusion
+// is failure if any run failed, success if all succeeded, and otherwise the first
+// other conclusion. The duration spans the first start to the last update.
+func summariseWorkflowRuns(runs []workflowRun) (workflowResult, bool) {
+	var result workflowResult
+	var start, end time.Time
+	completed := 0
+	for _, run := range runs {
+		if run.Status != "completed" {
+			continue
+		}
+		completed++
+		switch {
+		case run.Conclusion == "failure":
+			result.Conclusion = "failure"
+		case result.Conclusion == "" || result.Conclusion == "success":
+			result.Conclusion = run.Conclusion
+		}
 
-func formatDate(dateStr *string) string {
-	if dateStr == nil {
-		return "N/A"
+		started, err := time.Parse(time.RFC3339, run.RunStartedAt)
+		if err != nil {
+			continue
+		}
+		updated, err := time.Parse(time.RFC3339, run.UpdatedAt)
+		if err != nil {
+			continue
+		}
+		if start.IsZero() || started.Before(start) {
+			start = started
+		}
+		if updated.After(end) {
+			end = updated
+		}
 	}
-	t, err := time.Parse(time.RFC3339, *dateStr)
-	if err != nil {
-		return *dateStr
+	if completed == 0 {
+		return result, false
 	}
-	return t.Format("2006-01-02 15:04")
+	if !start.IsZero() {
+		result.DurationMinutes = end.Sub(start).Minutes()
+	}
+	return result, true
 }
 
-func formatDateString(dateStr string) string {
-	t, err := time.Parse(time.RFC3339, dateStr)
-	if err != nil {
-		return dateStr
+// getWorkflowResults fetches each repository's workflow runs from the first PR's
+// creation to the last merge, and links the runs on each PR's final head commit
+// to the PR, keyed by "owner/repo#number"
+func getWorkflowResults(token string, prs []PullRequest) map[string]workflowResult {
+	byRepo := make(map[string][]PullRequest)
+	for _, pr := range prs {
+		if pr.MergedAt != nil && pr.HeadRefOid != "" {
+			repo := repoFullName(pr.Repository)
+			byRepo[repo] = append(byRepo[repo], pr)
+		}
 	}
-	return t.Format("2006-01-02 15:04")
-}
 
-func repoFullName(repo Repository) string {
-	return repo.Owner.Login + "/" + repo.Name
+	results := make(map[string]workflowResult)
+	for repo, repoPRs := range byRepo {
+		from, to := repoPRs[0].CreatedAt, *repoPRs[0].MergedAt
+		for _, pr := range repoPRs {
+			if pr.CreatedAt < from {
+				from = pr.CreatedAt
+			}
+			if *pr.MergedAt > to {
+				to = *pr.MergedAt
+			}
+		}
+
+		runs, err := getWorkflowRuns(token, repo, from[:10], to[:10])
+		if err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+			continue
+		}
+		bySHA := make(map[string][]workflowRun)
+		for _, run := range runs {
+			bySHA[run.HeadSHA] = append(bySHA[run.HeadSHA], run)
+		}
+		for _, pr := range repoPRs {
+			if result, ok := summariseWorkflowRuns(bySHA[pr.HeadRefOid]); ok {
+				results[fmt.Sprintf("%s#%d", repo, pr.Number)] = result
+			}
+		}
+	}
+	return results
+}
+
+// getBehindBy returns how many commits base has that head doesn't, from the
+// REST compare endpoint
+func getBehindBy(client *http.Client, token, repo, base, head string) (int, error) {
+	resp, err := githubRESTGet(client, token, githubRESTURL+"/repos/"+repo+"/compare/"+base+"..."+head+"?per_page=1")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("compare request for %s failed with status %d", repo, resp.StatusCode)
+	}
+
+	var comparison struct {
+		BehindBy int `json:"behind_by"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&comparison); err != nil {
+		return 0, fmt.Errorf("failed to decode comparison for %s: %w", repo, err)
+	}
+	return comparison.BehindBy, nil
+}
+
+// getDivergence compares each PR's last base commit with its final head commit,
+// keyed by "owner/repo#number". The base commit is the base branch tip GitHub
+// recorded for the PR, so this approximates how far behind the branch was when merged.
+func getDivergence(token string, prs []PullRequest) map[string]int {
+	client := &http.Client{Timeout: 30 * time.Second}
+	divergence := make(map[string]int)
+	for _, pr := range prs {
+		if pr.BaseRefOid == "" || pr.HeadRefOid == "" {
+			continue
+		}
+		repo := repoFullName(pr.Repository)
+		behind, err := getBehindBy(client, token, repo, pr.BaseRefOid, pr.HeadRefOid)
+		if err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+			continue
+		}
+		divergence[fmt.Sprintf("%s#%d", repo, pr.Number)] = behind
+	}
+	return divergence
+}
+
+// runRateLimit prints the remaining GraphQL and REST quota, exiting with status 1
+// when less than rateLimitMinRemaining of the GraphQL budget is left
+func runRateLimit(token string) {
+	query := `
+	query GetRateLimit {
+		rateLimit {
+			limit
+			remaining
+			resetAt
+		}
+	}
+	`
+
+	resp, err := makeGraphQLRequest(token, query, nil)
+	if err != nil {
+		fmt.Printf("❌ Error: failed to fetch rate limit: %v\n", err)
+		os.Exit(1)
+	}
+	rl := resp.Data.RateLimit
+	if rl == nil {
+		fmt.Println("❌ Error: rate limit missing from response")
+		os.Exit(1)
+	}
+
+	formatReset := func(t time.Time) string {
+		return fmt.Sprintf("%s (in %s)", t.Local().Format("15:04:05"), time.Until(t).Round(time.Second))
+	}
+
+	fmt.Printf("%-10s %8s %10s %8s  %s\n", "API", "Limit", "Remaining", "Left", "Resets")
+	fmt.Println(strings.Repeat("-", 70))
+
+	graphQLLeft := 0.0
+	if rl.Limit > 0 {
+		graphQLLeft = float64(rl.Remaining) / float64(rl.Limit)
+	}
+	reset := rl.ResetAt
+	if resetAt, err := time.Parse(time.RFC3339, rl.ResetAt); err == nil {
+		reset = formatReset(resetAt)
+	}
+	fmt.Printf("%-10s %8d %10d %7.0f%%  %s\n", "GraphQL", rl.Limit, rl.Remaining, graphQLLeft*100, reset)
+
+	if headers, err := getRESTRateLimit(token); err != nil {
+		fmt.Printf("⚠️  %v\n", err)
+	} else {
+		limit, _ := strconv.Atoi(headers.Get("X-RateLimit-Limit"))
+		remaining, _ := strconv.Atoi(headers.Get("X-RateLimit-Remaining"))
+		resetUnix, _ := strconv.ParseInt(headers.Get("X-RateLimit-Reset"), 10, 64)
+		left := 0.0
+		if limit > 0 {
+			left = float64(remaining) / float64(limit)
+		}
+		fmt.Printf("%-10s %8d %10d %7.0f%%  %s\n", "REST", limit, remaining, left*100, formatReset(time.Unix(resetUnix, 0)))
+	}
+
+	if graphQLLeft < rateLimitMinRemaining {
+		fmt.Printf("\n❌ Less than %.0f%% of the GraphQL rate limit remains\n", rateLimitMinRemaining*100)
+		os.Exit(1)
+	}
+}
+
+// queryCostTracker accumulates GraphQL query cost across paginated requests
+type queryCostTracker struct {
+	TotalCost int
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+	warned    bool
+}
+
+// record adds a response's rate limit data to the tracker
+func (t *queryCostTracker) record(rl *RateLimit) {
+	if rl == nil {
+		return
+	}
+	t.TotalCost += rl.Cost
+	t.Limit = rl.Limit
+	t.Remaining = rl.Remaining
+	if resetAt, err := time.Parse(time.RFC3339, rl.ResetAt); err == nil {
+		t.ResetAt = resetAt
+	}
+}
+
+// usedFraction returns the share of the hourly budget used so far
+func (t *queryCostTracker) usedFraction() float64 {
+	if t.Limit == 0 {
+		return 0
+	}
+	return float64(t.Limit-t.Remaining) / float64(t.Limit)
+}
+
+// throttle warns once the budget is past the threshold and, if wait is set,
+// sleeps until the budget resets
+func (t *queryCostTracker) throttle(wait bool) {
+	if t.usedFraction() < rateLimitWarnThreshold {
+		return
+	}
+	if !t.warned {
+		fmt.Printf("⚠️  GraphQL rate limit: %d of %d points remaining (resets at %s)\n",
+			t.Remaining, t.Limit, t.ResetAt.Local().Format("15:04:05"))
+		t.warned = true
+	}
+	if wait {
+		if delay := time.Until(t.ResetAt); delay > 0 {
+			fmt.Printf("⏳ Waiting %s for the rate limit to reset...\n", delay.Round(time.Second))
+			time.Sleep(delay)
+			t.warned = false
+		}
+	}
+}
+
+// buildSearchQuery builds the GitHub search string for merged PRs in the date range.
+// In org stats mode every author in the org is included, not just the viewer.
+func buildSearchQuery(cfg *Config) string {
+	parts := []string{"is:pr"}
+	if !cfg.OrgStats {
+		parts = append(parts, "author:@me")
+	}
+	if cfg.Org != "" {
+		parts = append(parts, "org:"+cfg.Org)
+	}
+	parts = append(parts, "is:merged", fmt.Sprintf("merged:%s..%s", mergedStartDate, mergedEndDate))
+	return strings.Join(parts, " ")
+}
+
+// getMergedPullRequests fetches all merged PRs using cursor-based pagination.
+// In preview mode, or with pagination disabled, only the first page is fetched.
+func getMergedPullRequests(token string, cfg *Config, tracker *queryCostTracker) ([]PullRequest, error) {
+	var allPRs []PullRequest
+	var afterCursor *string
+	searchQuery := buildSearchQuery(cfg)
+
+	fmt.Println("Fetching merged pull requests...")
+
+	for {
+		variables := map[string]interface{}{
+			"queryString":  searchQuery,
+			"first":        cfg.pageSize(),
+			"after":        afterCursor,
+			"withCommits":  cfg.fetchesCommits(),
+			"withProjects": cfg.ProjectBoards,
+		}
+
+		resp, err := makeGraphQLRequest(token, mergedPRsQuery, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+		}
+		tracker.record(resp.Data.RateLimit)
+
+		for _, edge := range resp.Data.Search.Edges {
+			pr := edge.Node
+			pr.Reviews.tallyStates()
+			allPRs = append(allPRs, pr)
+		}
+
+		fmt.Printf("Fetched %d PRs (total: %d / %d)\n",
+			len(resp.Data.Search.Edges), len(allPRs), resp.Data.Search.IssueCount)
+
+		if cfg.Preview || cfg.NoPagination || !resp.Data.Search.PageInfo.HasNextPage {
+			break
+		}
+		tracker.throttle(cfg.WaitOnRateLimit)
+		afterCursor = resp.Data.Search.PageInfo.EndCursor
+	}
+
+	return allPRs, nil
+}
+
+// Helper functions
+
+func formatDate(dateStr *string) string {
+	if dateStr == nil {
+		return "N/A"
+	}
+	t, err := time.Parse(time.RFC3339, *dateStr)
+	if err != nil {
+		return *dateStr
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+func formatDateString(dateStr string) string {
+	t, err := time.Parse(time.RFC3339, dateStr)
+	if err != nil {
+		return dateStr
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+func repoFullName(repo Repository) string {
+	return repo.Owner.Login + "/" + repo.Name
 }
 
 func truncate(s string, maxLen int) string {
@@ -262,226 +1216,3566 @@ func truncate(s string, maxLen int) string {
 	if maxLen <= 3 {
 		return s[:maxLen]
 	}
-	return s[:maxLen-3] + "..."
+	return s[:maxLen-3] + "..."
+}
+
+// bodyWordCount returns the number of words in the PR description
+func bodyWordCount(pr PullRequest) int {
+	return len(strings.Fields(pr.Body))
+}
+
+// hasAnyTopic reports whether the repository is tagged with at least one of the topics
+func hasAnyTopic(repo Repository, topics []string) bool {
+	for _, node := range repo.RepositoryTopics.Nodes {
+		for _, topic := range topics {
+			if strings.EqualFold(node.Topic.Name, topic) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterPRs applies the client-side filters selected by command-line flags
+func filterPRs(prs []PullRequest, cfg *Config) []PullRequest {
+	var filtered []PullRequest
+	for _, pr := range prs {
+		if cfg.Milestone && pr.Milestone == nil {
+			continue
+		}
+		if cfg.NoMilestone && pr.Milestone != nil {
+			continue
+		}
+		if len(cfg.RepoTopics) > 0 && !hasAnyTopic(pr.Repository, cfg.RepoTopics) {
+			continue
+		}
+		if pr.Reviews.Approved < cfg.MinApprovals {
+			continue
+		}
+		if bodyWordCount(pr) < cfg.MinBodyWords {
+			continue
+		}
+		if cfg.ExcludeForks && pr.Repository.IsFork {
+			continue
+		}
+		if cfg.ExcludeArchived && pr.Repository.IsArchived {
+			continue
+		}
+		if cfg.ExcludeReopened && reopenCount(pr) > 0 {
+			continue
+		}
+		if cfg.RequireLinked && len(linkedIssueNumbers(pr)) == 0 {
+			continue
+		}
+		if cfg.ExcludeFailing && ciFailing(pr) {
+			continue
+		}
+		if cfg.ExcludeReverts && isRevert(pr) {
+			continue
+		}
+		if cfg.OnlyReverts && !isRevert(pr) {
+			continue
+		}
+		if cfg.ProtectedOnly && !baseIsProtected(pr) {
+			continue
+		}
+		if cfg.DraftConverted && draftConversionHours(pr) == nil {
+			continue
+		}
+		if cfg.ExcludeConflicts && hadConflicts(pr) {
+			continue
+		}
+		if cfg.Topic != "" && !strings.EqualFold(classifyPR(pr, cfg.TopicPatterns), cfg.Topic) {
+			continue
+		}
+		if cfg.MentionFilter != "" && !containsFold(bodyMentions(pr), cfg.MentionFilter) {
+			continue
+		}
+		if cfg.ReviewedBy != "" && !containsFold(reviewers(pr), cfg.ReviewedBy) {
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered
+}
+
+// ciStatusAtMerge returns the combined check state of the PR's head commit
+// (SUCCESS, FAILURE, ERROR, PENDING or EXPECTED), or "" when it has no checks
+func ciStatusAtMerge(pr PullRequest) string {
+	if len(pr.LastCommit.Nodes) == 0 || pr.LastCommit.Nodes[0].Commit.StatusCheckRollup == nil {
+		return ""
+	}
+	return pr.LastCommit.Nodes[0].Commit.StatusCheckRollup.State
+}
+
+// ciRunCounts counts the completed check suites on the PR's head commit that
+// passed and that failed (failure, timeout or startup failure)
+func ciRunCounts(pr PullRequest) (passed, failed int) {
+	if len(pr.LastCommit.Nodes) == 0 {
+		return 0, 0
+	}
+	for _, suite := range pr.LastCommit.Nodes[0].Commit.CheckSuites.Nodes {
+		if suite.Status != "COMPLETED" {
+			continue
+		}
+		switch suite.Conclusion {
+		case "SUCCESS":
+			passed++
+		case "FAILURE", "TIMED_OUT", "STARTUP_FAILURE":
+			failed++
+		}
+	}
+	return passed, failed
+}
+
+// ciFailing reports whether the PR was merged with failing or errored checks
+func ciFailing(pr PullRequest) bool {
+	status := ciStatusAtMerge(pr)
+	return status == "FAILURE" || status == "ERROR"
+}
+
+// suggestionsReceived counts review comments containing a ```suggestion block.
+// Whether a suggestion was applied is not exposed by the API.
+func suggestionsReceived(pr PullRequest) int {
+	count := 0
+	for _, review := range pr.ReviewComments.Nodes {
+		for _, comment := range review.Comments.Nodes {
+			if strings.Contains(comment.Body, "```suggestion") {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// mergeMethods lists the values inferMergeMethod can return, in display order
+var mergeMethods = []string{"merge", "squash", "rebase", "unknown"}
+
+// inferMergeMethod guesses how a PR was merged from its merge commit: two parents
+// mean a merge commit, a subject starting with the PR title means a squash (GitHub's
+// default squash message is "Title (#N)"), and anything else is taken as a rebase.
+// A merge commit that is, or sits directly on, the PR's head commit came from a
+// rebase of the PR's commits, even when its subject matches the title.
+func inferMergeMethod(pr PullRequest) string {
+	if pr.MergeCommit == nil {
+		return "unknown"
+	}
+	if pr.MergeCommit.Parents.TotalCount > 1 {
+		return "merge"
+	}
+	if pr.HeadRefOid != "" {
+		if pr.MergeCommit.Oid == pr.HeadRefOid {
+			return "rebase"
+		}
+		for _, parent := range pr.MergeCommit.Parents.Nodes {
+			if parent.Oid == pr.HeadRefOid {
+				return "rebase"
+			}
+		}
+	}
+	subject, _, _ := strings.Cut(pr.MergeCommit.Message, "\n")
+	if strings.HasPrefix(strings.TrimSpace(subject), strings.TrimSpace(pr.Title)) {
+		return "squash"
+	}
+	return "rebase"
+}
+
+// reReviewRate returns review requests per submitted review, treating PRs
+// without reviews as having one
+func reReviewRate(pr PullRequest) float64 {
+	reviews := pr.Reviews.TotalCount
+	if reviews < 1 {
+		reviews = 1
+	}
+	return float64(pr.ReviewRequests.TotalCount) / float64(reviews)
+}
+
+// possibleBypass reports whether someone other than the author merged the PR
+// without any approving review, as an admin override of required reviews would
+func possibleBypass(pr PullRequest) bool {
+	return pr.MergedBy != nil && pr.MergedBy.Login != pr.Author.Login && pr.Reviews.Approved == 0
+}
+
+// bypassPRs returns the PRs that may have bypassed required reviews
+func bypassPRs(prs []PullRequest) []PullRequest {
+	var bypasses []PullRequest
+	for _, pr := range prs {
+		if possibleBypass(pr) {
+			bypasses = append(bypasses, pr)
+		}
+	}
+	return bypasses
+}
+
+// selfMerged reports whether the PR's author merged it themselves
+func selfMerged(pr PullRequest) bool {
+	return pr.MergedBy != nil && pr.MergedBy.Login == pr.Author.Login
+}
+
+// selfMergedPRs returns the PRs merged by their own author
+func selfMergedPRs(prs []PullRequest) []PullRequest {
+	var selfMerges []PullRequest
+	for _, pr := range prs {
+		if selfMerged(pr) {
+			selfMerges = append(selfMerges, pr)
+		}
+	}
+	return selfMerges
+}
+
+// baseIsProtected reports whether a branch protection rule covers the PR's base branch
+func baseIsProtected(pr PullRequest) bool {
+	return pr.BaseRef != nil && pr.BaseRef.BranchProtectionRule != nil
+}
+
+// isRevert reports whether the PR reverts an earlier change, going by GitHub's
+// default `Revert "..."` title
+func isRevert(pr PullRequest) bool {
+	return strings.HasPrefix(pr.Title, "Revert ")
+}
+
+// revertPRs returns the PRs that revert an earlier change
+func revertPRs(prs []PullRequest) []PullRequest {
+	var reverts []PullRequest
+	for _, pr := range prs {
+		if isRevert(pr) {
+			reverts = append(reverts, pr)
+		}
+	}
+	return reverts
+}
+
+// reopenCount returns how many times the PR was reopened after being closed
+func reopenCount(pr PullRequest) int {
+	count := 0
+	for _, item := range pr.CloseEvents.Nodes {
+		if item.Typename == "ReopenedEvent" {
+			count++
+		}
+	}
+	return count
+}
+
+// closingKeyword matches GitHub closing keywords followed by a same-repository
+// issue reference, such as "Closes #123" or "fixed: #7"
+var closingKeyword = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+#(\d+)\b`)
+
+// linkedIssueNumbers returns the issue numbers the PR body closes, in order of first mention
+func linkedIssueNumbers(pr PullRequest) []int {
+	seen := make(map[int]bool)
+	var numbers []int
+	for _, match := range closingKeyword.FindAllStringSubmatch(pr.Body, -1) {
+		n, err := strconv.Atoi(match[1])
+		if err != nil || seen[n] {
+			continue
+		}
+		seen[n] = true
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
+
+// coAuthorTrailer matches "Co-authored-by: Name <email>" commit message trailers
+var coAuthorTrailer = regexp.MustCompile(`(?mi)^co-authored-by:\s*(.+?)\s*$`)
+
+// topicPattern assigns Topic to PRs whose title or head branch matches Pattern
+type topicPattern struct {
+	Pattern *regexp.Regexp
+	Topic   string
+}
+
+// defaultTopicPatterns match Conventional Commits title prefixes such as
+// "feat(api):" and branch prefixes such as "feature/", in priority order
+var defaultTopicPatterns = []topicPattern{
+	{regexp.MustCompile(`(?i)^(feat|feature)(\([^)]*\))?!?[:/]`), "feature"},
+	{regexp.MustCompile(`(?i)^(fix|bugfix|hotfix)(\([^)]*\))?!?[:/]`), "fix"},
+	{regexp.MustCompile(`(?i)^(refactor|perf)(\([^)]*\))?!?[:/]`), "refactor"},
+	{regexp.MustCompile(`(?i)^tests?(\([^)]*\))?!?[:/]`), "test"},
+	{regexp.MustCompile(`(?i)^docs?(\([^)]*\))?!?[:/]`), "docs"},
+	{regexp.MustCompile(`(?i)^ci(\([^)]*\))?!?[:/]`), "ci"},
+	{regexp.MustCompile(`(?i)^(chore|build|deps|style)(\([^)]*\))?!?[:/]`), "chore"},
+}
+
+// readTopicPatterns reads a JSON array of {"pattern": "regexp", "topic": "name"}
+func readTopicPatterns(filename string) ([]topicPattern, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	var entries []struct {
+		Pattern string `json:"pattern"`
+		Topic   string `json:"topic"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	patterns := make([]topicPattern, len(entries))
+	for i, entry := range entries {
+		re, err := regexp.Compile(entry.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern for %s: %w", entry.Topic, err)
+		}
+		patterns[i] = topicPattern{Pattern: re, Topic: entry.Topic}
+	}
+	return patterns, nil
+}
+
+// classifyPR returns the topic of the first pattern matching the PR's title or
+// head branch, or "other"
+func classifyPR(pr PullRequest, patterns []topicPattern) string {
+	for _, p := range patterns {
+		if p.Pattern.MatchString(pr.Title) || p.Pattern.MatchString(pr.HeadRefName) {
+			return p.Topic
+		}
+	}
+	return "other"
+}
+
+// cherryPickTrailer matches the "(cherry picked from commit <sha>)" line that
+// git cherry-pick -x appends, capturing the source commit
+var cherryPickTrailer = regexp.MustCompile(`(?m)^\(cherry picked from commit ([0-9a-f]{7,40})\)\s*$`)
+
+// conventionalCommit matches a Conventional Commits subject such as
+// "feat(api)!: Add pagination", capturing the description after the prefix
+var conventionalCommit = regexp.MustCompile(`^(feat|fix|docs|style|refactor|perf|test|build|ci|chore|revert)(\([^)]+\))?!?: (.+)$`)
+
+// maxLintedCommits is the number of commits per PR checked by --lint-commits
+const maxLintedCommits = 20
+
+// lintCommitMessage scores a commit subject line from 0 to 1 on three equally
+// weighted rules: it follows the Conventional Commits format, its description
+// starts with a capital letter, and it is under 72 characters long
+func lintCommitMessage(msg string) float64 {
+	subject, _, _ := strings.Cut(strings.TrimSpace(msg), "\n")
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return 0
+	}
+
+	passed := 0
+	description := subject
+	if match := conventionalCommit.FindStringSubmatch(subject); match != nil {
+		passed++
+		description = match[3]
+	}
+	if first := []rune(description)[0]; unicode.IsUpper(first) {
+		passed++
+	}
+	if len([]rune(subject)) < 72 {
+		passed++
+	}
+	return float64(passed) / 3
+}
+
+// commitLintScore averages lintCommitMessage over the first commits of the PR
+func commitLintScore(pr PullRequest) float64 {
+	nodes := pr.Commits.Nodes
+	if len(nodes) > maxLintedCommits {
+		nodes = nodes[:maxLintedCommits]
+	}
+	if len(nodes) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, node := range nodes {
+		total += lintCommitMessage(node.Commit.Message)
+	}
+	return total / float64(len(nodes))
+}
+
+// parseCoAuthors returns the unique co-authors named in the PR's commit trailers
+func parseCoAuthors(pr PullRequest) []string {
+	seen := make(map[string]bool)
+	var coAuthors []string
+	for _, node := range pr.Commits.Nodes {
+		for _, match := range coAuthorTrailer.FindAllStringSubmatch(node.Commit.Message, -1) {
+			name := match[1]
+			if !seen[strings.ToLower(name)] {
+				seen[strings.ToLower(name)] = true
+				coAuthors = append(coAuthors, name)
+			}
+		}
+	}
+	return coAuthors
+}
+
+// hadAutoMerge reports whether auto-merge was ever enabled on the PR. The
+// pending autoMergeRequest disappears when the PR merges, so the
+// AutoMergeEnabled timeline event is what marks merged PRs.
+func hadAutoMerge(pr PullRequest) bool {
+	return pr.AutoMergeRequest != nil || len(pr.AutoMergeEnabled.Nodes) > 0
+}
+
+// conflictsSection matches the "Conflicts:" list git writes into the message of
+// a merge commit that needed conflict resolution, when it isn't stripped
+var conflictsSection = regexp.MustCompile(`(?m)^#?\s*Conflicts:\s*$`)
+
+// hadConflicts reports whether the PR is conflicting now or, when commits are
+// fetched, one of its merge commits kept git's Conflicts: list. GitHub keeps no
+// history of mergeability and git strips that list by default, so merged PRs
+// that resolved conflicts are often missed.
+func hadConflicts(pr PullRequest) bool {
+	if pr.Mergeable == "CONFLICTING" {
+		return true
+	}
+	for _, node := range pr.Commits.Nodes {
+		if conflictsSection.MatchString(node.Commit.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergedBaseBranch reports whether one of the PR's fetched commits merged the
+// base branch into the head branch
+func mergedBaseBranch(pr PullRequest) bool {
+	prCommits := make(map[string]bool, len(pr.Commits.Nodes))
+	for _, node := range pr.Commits.Nodes {
+		prCommits[node.Commit.Oid] = true
+	}
+	for _, node := range pr.Commits.Nodes {
+		if mergesBase(node.Commit, prCommits) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergesBase reports whether commit is a merge with a parent from outside the
+// PR. A PR's commits are those on its head branch but not its base, so such a
+// parent comes from the base branch, as after git merge, git pull or GitHub's
+// "Update branch" button.
+func mergesBase(commit Commit, prCommits map[string]bool) bool {
+	if commit.Parents.TotalCount < 2 {
+		return false
+	}
+	for _, parent := range commit.Parents.Nodes {
+		if !prCommits[parent.Oid] {
+			return true
+		}
+	}
+	return false
+}
+
+// cherryPickSource returns the source commit named by the first cherry-pick
+// trailer in the PR's commits, or "" when none of them were cherry-picked
+func cherryPickSource(pr PullRequest) string {
+	for _, node := range pr.Commits.Nodes {
+		if match := cherryPickTrailer.FindStringSubmatch(node.Commit.Message); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// cherryPickPRs returns the PRs that contain a cherry-picked commit
+func cherryPickPRs(prs []PullRequest) []PullRequest {
+	var picks []PullRequest
+	for _, pr := range prs {
+		if cherryPickSource(pr) != "" {
+			picks = append(picks, pr)
+		}
+	}
+	return picks
+}
+
+// branchCompliant reports whether the PR's head branch matches the configured pattern
+func branchCompliant(pr PullRequest, cfg *Config) bool {
+	return cfg.BranchPattern == nil || cfg.BranchPattern.MatchString(pr.HeadRefName)
+}
+
+// nonCompliantBranches returns the PRs whose head branch does not match the configured pattern
+func nonCompliantBranches(prs []PullRequest, cfg *Config) []PullRequest {
+	var violations []PullRequest
+	for _, pr := range prs {
+		if !branchCompliant(pr, cfg) {
+			violations = append(violations, pr)
+		}
+	}
+	return violations
+}
+
+// reviewToMergeHours returns the hours between a PR's first approval and its merge,
+// or nil if it was never approved. Reviews are returned in submission order, so
+// the first approved review is the earliest.
+func reviewToMergeHours(pr PullRequest) *float64 {
+	if pr.MergedAt == nil || len(pr.FirstApproval.Nodes) == 0 {
+		return nil
+	}
+	approvedAt, err := time.Parse(time.RFC3339, pr.FirstApproval.Nodes[0].SubmittedAt)
+	if err != nil {
+		return nil
+	}
+	mergedAt, err := time.Parse(time.RFC3339, *pr.MergedAt)
+	if err != nil {
+		return nil
+	}
+	hours := mergedAt.Sub(approvedAt).Hours()
+	return &hours
+}
+
+// percentile returns the p-th percentile (0-100) of values using nearest-rank
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// ReviewerStat summarizes how quickly one reviewer first reviewed the PRs
+type ReviewerStat struct {
+	Login         string
+	Reviews       int
+	MedianHours   float64
+	turnaroundHrs []float64
+}
+
+// computeReviewerStats measures, for every reviewer other than the PR author,
+// the hours from PR creation to their first review, ordered fastest first
+func computeReviewerStats(prs []PullRequest) []ReviewerStat {
+	byLogin := make(map[string]*ReviewerStat)
+	for _, pr := range prs {
+		createdAt, err := time.Parse(time.RFC3339, pr.CreatedAt)
+		if err != nil {
+			continue
+		}
+
+		first := make(map[string]time.Time)
+		for _, review := range pr.Reviews.Nodes {
+			login := review.Author.Login
+			if login == "" || login == pr.Author.Login {
+				continue
+			}
+			submittedAt, err := time.Parse(time.RFC3339, review.SubmittedAt)
+			if err != nil {
+				continue
+			}
+			if t, ok := first[login]; !ok || submittedAt.Before(t) {
+				first[login] = submittedAt
+			}
+		}
+
+		for login, submittedAt := range first {
+			stat, ok := byLogin[login]
+			if !ok {
+				stat = &ReviewerStat{Login: login}
+				byLogin[login] = stat
+			}
+			stat.Reviews++
+			stat.turnaroundHrs = append(stat.turnaroundHrs, submittedAt.Sub(createdAt).Hours())
+		}
+	}
+
+	stats := make([]ReviewerStat, 0, len(byLogin))
+	for _, stat := range byLogin {
+		stat.MedianHours = percentile(stat.turnaroundHrs, 50)
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].MedianHours != stats[j].MedianHours {
+			return stats[i].MedianHours < stats[j].MedianHours
+		}
+		return stats[i].Login < stats[j].Login
+	})
+	return stats
+}
+
+// firstReviewResponseHours returns the hours from PR creation to the first
+// review by someone other than the author, or nil if nobody else reviewed it
+func firstReviewResponseHours(pr PullRequest) *float64 {
+	createdAt, err := time.Parse(time.RFC3339, pr.CreatedAt)
+	if err != nil {
+		return nil
+	}
+
+	var first *time.Time
+	for _, review := range pr.Reviews.Nodes {
+		if review.Author.Login == "" || review.Author.Login == pr.Author.Login {
+			continue
+		}
+		submittedAt, err := time.Parse(time.RFC3339, review.SubmittedAt)
+		if err != nil {
+			continue
+		}
+		if first == nil || submittedAt.Before(*first) {
+			first = &submittedAt
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	hours := first.Sub(createdAt).Hours()
+	return &hours
+}
+
+// draftConversionHours returns the hours from creation until the PR was first
+// marked ready for review, or nil when it was never a draft
+func draftConversionHours(pr PullRequest) *float64 {
+	if len(pr.ReadyForReview.Nodes) == 0 {
+		return nil
+	}
+	createdAt, err := time.Parse(time.RFC3339, pr.CreatedAt)
+	if err != nil {
+		return nil
+	}
+	readyAt, err := time.Parse(time.RFC3339, pr.ReadyForReview.Nodes[0].CreatedAt)
+	if err != nil {
+		return nil
+	}
+	hours := readyAt.Sub(createdAt).Hours()
+	return &hours
+}
+
+// reviewCoverage returns the share of the PR's changed files that have at least
+// one review thread, or 0 when the PR changed no files
+func reviewCoverage(pr PullRequest) float64 {
+	if pr.ChangedFiles == 0 {
+		return 0
+	}
+	changed := make(map[string]bool, len(pr.Files.Nodes))
+	for _, file := range pr.Files.Nodes {
+		changed[file.Path] = true
+	}
+	commented := make(map[string]bool)
+	for _, thread := range pr.ReviewThreads.Nodes {
+		if changed[thread.Path] {
+			commented[thread.Path] = true
+		}
+	}
+	return float64(len(commented)) / float64(pr.ChangedFiles)
+}
+
+// pearson returns the Pearson correlation coefficient of xs and ys, or NaN
+// when there are fewer than two pairs or either series is constant
+func pearson(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return math.NaN()
+	}
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return math.NaN()
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// sizeReviewPairs returns the lines changed and first review response hours of
+// every PR that was reviewed by someone other than its author
+func sizeReviewPairs(prs []PullRequest) (reviewed []PullRequest, sizes, hours []float64) {
+	for _, pr := range prs {
+		if h := firstReviewResponseHours(pr); h != nil {
+			reviewed = append(reviewed, pr)
+			sizes = append(sizes, float64(pr.Additions+pr.Deletions))
+			hours = append(hours, *h)
+		}
+	}
+	return reviewed, sizes, hours
+}
+
+// correlationPRSizeReviewTime returns the Pearson correlation between lines
+// changed and hours to first review
+func correlationPRSizeReviewTime(prs []PullRequest) float64 {
+	_, sizes, hours := sizeReviewPairs(prs)
+	return pearson(sizes, hours)
+}
+
+// describeCorrelation puts a correlation coefficient into words
+func describeCorrelation(r float64) string {
+	direction := "positive"
+	if r < 0 {
+		direction = "negative"
+	}
+	switch abs := math.Abs(r); {
+	case abs >= 0.7:
+		return "strong " + direction + " correlation"
+	case abs >= 0.4:
+		return "moderate " + direction + " correlation"
+	case abs >= 0.2:
+		return "weak " + direction + " correlation"
+	default:
+		return "no meaningful correlation"
+	}
+}
+
+// exportSizeReviewCorrelationToCSV exports one row per reviewed PR with its size
+// and hours to first review, for charting as a scatter plot
+func exportSizeReviewCorrelationToCSV(prs []PullRequest, filename string) error {
+	reviewed, sizes, hours := sizeReviewPairs(prs)
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Repository", "PR#", "Lines Changed", "Hours To First Review"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for i, pr := range reviewed {
+		row := []string{
+			repoFullName(pr.Repository),
+			strconv.Itoa(pr.Number),
+			fmt.Sprintf("%.0f", sizes[i]),
+			fmt.Sprintf("%.1f", hours[i]),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported size and review time of %d PRs to %s\n", len(reviewed), filename)
+	return nil
+}
+
+// RepoReviewStat summarizes how quickly a repository's PRs got their first review
+type RepoReviewStat struct {
+	AvgFirstReviewHours    float64
+	MedianFirstReviewHours float64
+	PRCount                int
+}
+
+// computeRepoReviewStats aggregates first review response times by repository,
+// counting only PRs that were reviewed by someone other than their author
+func computeRepoReviewStats(prs []PullRequest) map[string]RepoReviewStat {
+	hoursByRepo := make(map[string][]float64)
+	for _, pr := range prs {
+		if hours := firstReviewResponseHours(pr); hours != nil {
+			repo := repoFullName(pr.Repository)
+			hoursByRepo[repo] = append(hoursByRepo[repo], *hours)
+		}
+	}
+
+	stats := make(map[string]RepoReviewStat, len(hoursByRepo))
+	for repo, hours := range hoursByRepo {
+		total := 0.0
+		for _, h := range hours {
+			total += h
+		}
+		stats[repo] = RepoReviewStat{
+			AvgFirstReviewHours:    total / float64(len(hours)),
+			MedianFirstReviewHours: percentile(hours, 50),
+			PRCount:                len(hours),
+		}
+	}
+	return stats
+}
+
+// reposByMedianFirstReview returns the repositories in stats ordered fastest first
+func reposByMedianFirstReview(stats map[string]RepoReviewStat) []string {
+	repos := make([]string, 0, len(stats))
+	for repo := range stats {
+		repos = append(repos, repo)
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		a, b := stats[repos[i]], stats[repos[j]]
+		if a.MedianFirstReviewHours != b.MedianFirstReviewHours {
+			return a.MedianFirstReviewHours < b.MedianFirstReviewHours
+		}
+		return repos[i] < repos[j]
+	})
+	return repos
+}
+
+// printRepoReviewStats prints the five fastest and five slowest repositories by
+// median time to first review
+func printRepoReviewStats(stats map[string]RepoReviewStat) {
+	if len(stats) == 0 {
+		return
+	}
+	repos := reposByMedianFirstReview(stats)
+	printRepo := func(repo string) {
+		s := stats[repo]
+		fmt.Printf("  %-40s median %6.1fh, avg %6.1fh  (%d PRs)\n", repo, s.MedianFirstReviewHours, s.AvgFirstReviewHours, s.PRCount)
+	}
+
+	fmt.Println("\nFastest repositories to first review:")
+	for i := 0; i < len(repos) && i < 5; i++ {
+		printRepo(repos[i])
+	}
+	fmt.Println("Slowest repositories to first review:")
+	for i := len(repos) - 1; i >= 0 && i >= len(repos)-5; i-- {
+		printRepo(repos[i])
+	}
+}
+
+// exportRepoReviewStatsToCSV exports per-repository first review times to a CSV file
+func exportRepoReviewStatsToCSV(stats map[string]RepoReviewStat, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Repository", "Reviewed PRs", "Avg Hours To First Review", "Median Hours To First Review"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, repo := range reposByMedianFirstReview(stats) {
+		s := stats[repo]
+		row := []string{
+			repo,
+			strconv.Itoa(s.PRCount),
+			fmt.Sprintf("%.1f", s.AvgFirstReviewHours),
+			fmt.Sprintf("%.1f", s.MedianFirstReviewHours),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported review stats for %d repositories to %s\n", len(stats), filename)
+	return nil
+}
+
+// printFastestReviewers prints the five reviewers with the lowest median turnaround
+func printFastestReviewers(stats []ReviewerStat) {
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Println("\nFastest reviewers (median time to first review):")
+	for i, stat := range stats {
+		if i == 5 {
+			break
+		}
+		fmt.Printf("  %-25s %6.1fh  (%d PRs)\n", stat.Login, stat.MedianHours, stat.Reviews)
+	}
+}
+
+// exportReviewerStatsToCSV exports per-reviewer turnaround to a CSV file
+func exportReviewerStatsToCSV(stats []ReviewerStat, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Reviewer", "PRs Reviewed", "Median Hours To First Review"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, stat := range stats {
+		row := []string{stat.Login, strconv.Itoa(stat.Reviews), fmt.Sprintf("%.1f", stat.MedianHours)}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported stats for %d reviewers to %s\n", len(stats), filename)
+	return nil
+}
+
+// prTableHeader is the column header shared by the static and interactive tables
+var prTableHeader = fmt.Sprintf("%-30s %-7s %-42s %-25s %-18s %-10s",
+	"Repo", "PR#", "Title", "Branch", "Merged At", "+/-")
+
+// prTableRow formats a single pull request as a fixed-width table row
+func prTableRow(pr PullRequest) string {
+	repo := truncate(repoFullName(pr.Repository), 30)
+	title := truncate(pr.Title, 42)
+	branch := truncate(pr.HeadRefName, 25)
+	mergedAt := formatDate(pr.MergedAt)
+	changes := fmt.Sprintf("+%d/-%d", pr.Additions, pr.Deletions)
+
+	return fmt.Sprintf("%-30s %-7d %-42s %-25s %-18s %-10s",
+		repo, pr.Number, title, branch, mergedAt, changes)
+}
+
+// printPRsTable displays pull requests in a formatted console table
+func printPRsTable(prs []PullRequest) {
+	if len(prs) == 0 {
+		fmt.Println("\nNo pull requests found.")
+		return
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 135))
+	fmt.Println(prTableHeader)
+	fmt.Println(strings.Repeat("=", 135))
+
+	for _, pr := range prs {
+		fmt.Println(prTableRow(pr))
+	}
+
+	fmt.Println(strings.Repeat("=", 135))
+}
+
+// tuiRow is a single selectable line in the interactive view
+type tuiRow struct {
+	Text string
+	URL  string
+}
+
+// isTerminal reports whether f is attached to a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// stty runs stty against the controlling terminal and returns its output
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// terminalHeight returns the number of rows in the terminal, defaulting to 24
+func terminalHeight() int {
+	if out, err := stty("size"); err == nil {
+		var rows, cols int
+		if _, err := fmt.Sscanf(out, "%d %d", &rows, &cols); err == nil && rows > 0 {
+			return rows
+		}
+	}
+	return 24
+}
+
+// openURL opens url in the default browser
+func openURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// filterRows returns the rows whose text contains query, case-insensitively
+func filterRows(rows []tuiRow, query string) []tuiRow {
+	if query == "" {
+		return rows
+	}
+	query = strings.ToLower(query)
+	var matched []tuiRow
+	for _, row := range rows {
+		if strings.Contains(strings.ToLower(row.Text), query) {
+			matched = append(matched, row)
+		}
+	}
+	return matched
+}
+
+// runInteractive shows rows in a scrollable view. Arrow keys (or j/k) move the
+// selection, Enter opens the selected URL, / searches and q quits. The terminal
+// is switched to raw mode with stty for the duration.
+func runInteractive(header string, rows []tuiRow) error {
+	saved, err := stty("-g")
+	if err != nil {
+		return fmt.Errorf("failed to read terminal state: %w", err)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return fmt.Errorf("failed to enable raw mode: %w", err)
+	}
+	defer func() {
+		stty(saved)
+		fmt.Print("\x1b[?25h\x1b[H\x1b[2J")
+	}()
+
+	reader := bufio.NewReader(os.Stdin)
+	visible := rows
+	selected, offset := 0, 0
+	query, status := "", ""
+	searching := false
+
+	for {
+		height := terminalHeight() - 3
+		if height < 1 {
+			height = 1
+		}
+		if selected < offset {
+			offset = selected
+		}
+		if selected >= offset+height {
+			offset = selected - height + 1
+		}
+
+		var b strings.Builder
+		b.WriteString("\x1b[?25l\x1b[H\x1b[2J")
+		b.WriteString("\x1b[1m" + header + "\x1b[0m\r\n")
+		for i := offset; i < len(visible) && i < offset+height; i++ {
+			if i == selected {
+				b.WriteString("\x1b[7m" + visible[i].Text + "\x1b[0m\r\n")
+			} else {
+				b.WriteString(visible[i].Text + "\r\n")
+			}
+		}
+		fmt.Fprintf(&b, "\x1b[%d;1H", height+2)
+		if searching {
+			b.WriteString("/" + query)
+		} else {
+			fmt.Fprintf(&b, "%d/%d  ↑/↓ move  Enter open  / search  q quit  %s",
+				min(selected+1, len(visible)), len(visible), status)
+		}
+		fmt.Print(b.String())
+
+		key, err := reader.ReadByte()
+		if err != nil {
+			return nil
+		}
+
+		// Escape sequences (arrow keys) arrive together; a lone ESC does not
+		if key == 0x1b && reader.Buffered() >= 2 {
+			seq := make([]byte, 2)
+			if _, err := io.ReadFull(reader, seq); err != nil {
+				return nil
+			}
+			if searching || seq[0] != '[' {
+				continue
+			}
+			switch seq[1] {
+			case 'A':
+				key = 'k'
+			case 'B':
+				key = 'j'
+			default:
+				continue
+			}
+		}
+
+		if searching {
+			switch key {
+			case '\r', '\n':
+				searching = false
+			case 0x1b:
+				searching = false
+				query = ""
+			case 127, 8:
+				if len(query) > 0 {
+					query = query[:len(query)-1]
+				}
+			default:
+				if key >= 32 {
+					query += string(key)
+				}
+			}
+			visible = filterRows(rows, query)
+			selected, offset = 0, 0
+			continue
+		}
+
+		status = ""
+		switch key {
+		case 'q', 3:
+			return nil
+		case '/':
+			searching = true
+			query = ""
+		case 'k':
+			if selected > 0 {
+				selected--
+			}
+		case 'j':
+			if selected < len(visible)-1 {
+				selected++
+			}
+		case '\r', '\n':
+			if len(visible) == 0 {
+				continue
+			}
+			if err := openURL(visible[selected].URL); err != nil {
+				status = "failed to open browser: " + err.Error()
+			} else {
+				status = "opened " + visible[selected].URL
+			}
+		}
+	}
+}
+
+// showPRs displays pull requests in the interactive view when requested and
+// possible, falling back to the static table otherwise
+func showPRs(prs []PullRequest, cfg *Config) {
+	if !cfg.Interactive || len(prs) == 0 {
+		printPRsTable(prs)
+		return
+	}
+	if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		fmt.Println("\nℹ️  Not running in a terminal, showing the static table instead")
+		printPRsTable(prs)
+		return
+	}
+
+	rows := make([]tuiRow, len(prs))
+	for i, pr := range prs {
+		rows[i] = tuiRow{Text: prTableRow(pr), URL: pr.URL}
+	}
+	if err := runInteractive(prTableHeader, rows); err != nil {
+		fmt.Printf("❌ Error in interactive mode: %v\n", err)
+		printPRsTable(prs)
+	}
+}
+
+// printSummary displays summary statistics about the pull requests
+func printSummary(prs []PullRequest, cfg *Config, tracker *queryCostTracker) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Println("SUMMARY")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("Total merged PRs: %d\n", len(prs))
+	fmt.Printf("Date range: %s - %s\n", startDateDisplay, endDateDisplay)
+
+	if len(prs) > 0 {
+		repos := make(map[string]int)
+		totalAdditions := 0
+		totalDeletions := 0
+
+		for _, pr := range prs {
+			repos[repoFullName(pr.Repository)]++
+			totalAdditions += pr.Additions
+			totalDeletions += pr.Deletions
+		}
+
+		fmt.Println("\nPRs by repository:")
+		for repo, count := range repos {
+			fmt.Printf("  %s: %d\n", repo, count)
+		}
+
+		topics := make(map[string]int)
+		for _, pr := range prs {
+			topics[classifyPR(pr, cfg.TopicPatterns)]++
+		}
+		names := make([]string, 0, len(topics))
+		for topic := range topics {
+			names = append(names, topic)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if topics[names[i]] != topics[names[j]] {
+				return topics[names[i]] > topics[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		fmt.Println("\nPRs by topic:")
+		for _, topic := range names {
+			fmt.Printf("  %s: %d (%.1f%%)\n", topic, topics[topic], float64(topics[topic])/float64(len(prs))*100)
+		}
+
+		fmt.Printf("\nTotal lines added:   +%d\n", totalAdditions)
+		fmt.Printf("Total lines deleted: -%d\n", totalDeletions)
+
+		printSparklines(prs)
+		printHeatmap(computeDayHourHeatmap(prs, cfg.Location), cfg.Location)
+		printDeployFrequency(computeDeployFrequency(prs, cfg.DeployBranch), cfg.DeployBranch)
+		printDORA(computeDORA(prs, cfg.DeployBranch))
+
+		reviewed, approved := 0, 0
+		for _, pr := range prs {
+			if pr.Reviews.TotalCount > 0 {
+				reviewed++
+			}
+			if pr.Reviews.Approved > 0 {
+				approved++
+			}
+		}
+		if reviewed > 0 {
+			fmt.Printf("\nApproval rate: %.1f%% (%d of %d reviewed PRs approved)\n",
+				float64(approved)/float64(reviewed)*100, approved, reviewed)
+		}
+
+		drafts, totalDraftHours := 0, 0.0
+		for _, pr := range prs {
+			if h := draftConversionHours(pr); h != nil {
+				drafts++
+				totalDraftHours += *h
+			}
+		}
+		if drafts > 0 {
+			fmt.Printf("Mean draft-to-ready time: %.1fh (%d PRs started as drafts)\n", totalDraftHours/float64(drafts), drafts)
+		}
+
+		withFiles, totalCoverage := 0, 0.0
+		for _, pr := range prs {
+			if pr.ChangedFiles > 0 {
+				withFiles++
+				totalCoverage += reviewCoverage(pr)
+			}
+		}
+		if withFiles > 0 {
+			fmt.Printf("Mean review coverage: %.1f%% of changed files commented on\n", totalCoverage/float64(withFiles)*100)
+		}
+
+		printMostMentioned(prs)
+		printFastestReviewers(computeReviewerStats(prs))
+		printRepoReviewStats(computeRepoReviewStats(prs))
+		if r := correlationPRSizeReviewTime(prs); !math.IsNaN(r) {
+			fmt.Printf("\nPR size vs time to first review: r = %.2f (%s)\n", r, describeCorrelation(r))
+		}
+
+		totalRate := 0.0
+		for _, pr := range prs {
+			totalRate += reReviewRate(pr)
+		}
+		fmt.Printf("Mean re-review rate: %.2f review requests per review\n", totalRate/float64(len(prs)))
+
+		suggestions := 0
+		for _, pr := range prs {
+			suggestions += suggestionsReceived(pr)
+		}
+		fmt.Printf("Review suggestions received: %d\n", suggestions)
+
+		totalWords := 0
+		var emptyBodies []PullRequest
+		for _, pr := range prs {
+			words := bodyWordCount(pr)
+			totalWords += words
+			if words == 0 {
+				emptyBodies = append(emptyBodies, pr)
+			}
+		}
+		fmt.Printf("\nMean description word count: %.1f\n", float64(totalWords)/float64(len(prs)))
+
+		linked := 0
+		for _, pr := range prs {
+			if len(linkedIssueNumbers(pr)) > 0 {
+				linked++
+			}
+		}
+		fmt.Printf("PRs referencing an issue: %.1f%% (%d of %d)\n", float64(linked)/float64(len(prs))*100, linked, len(prs))
+		if len(emptyBodies) > 0 {
+			fmt.Printf("\nPRs lacking description: %d\n", len(emptyBodies))
+			for _, pr := range emptyBodies {
+				fmt.Printf("  %s#%d: %s\n", repoFullName(pr.Repository), pr.Number, pr.Title)
+			}
+		}
+
+		var mergeLatencies []float64
+		for _, pr := range prs {
+			if hours := reviewToMergeHours(pr); hours != nil {
+				mergeLatencies = append(mergeLatencies, *hours)
+			}
+		}
+		if len(mergeLatencies) > 0 {
+			total := 0.0
+			for _, h := range mergeLatencies {
+				total += h
+			}
+			fmt.Println("\nFirst approval to merge:")
+			fmt.Printf("  Mean: %.1fh\n", total/float64(len(mergeLatencies)))
+			fmt.Printf("  P90:  %.1fh\n", percentile(mergeLatencies, 90))
+		}
+
+		printBaseBranchChart(baseBranchCounts(prs), 5)
+
+		failing, pending := 0, 0
+		for _, pr := range prs {
+			switch ciStatusAtMerge(pr) {
+			case "FAILURE", "ERROR":
+				failing++
+			case "PENDING", "EXPECTED":
+				pending++
+			}
+		}
+		fmt.Printf("\nMerged with failing CI: %d\n", failing)
+		fmt.Printf("Merged with pending CI: %d\n", pending)
+
+		methods := make(map[string]int)
+		for _, pr := range prs {
+			methods[inferMergeMethod(pr)]++
+		}
+		fmt.Println("\nMerge methods (inferred):")
+		for _, method := range mergeMethods {
+			if methods[method] > 0 {
+				fmt.Printf("  %-8s %4d  %5.1f%%\n", method, methods[method], float64(methods[method])/float64(len(prs))*100)
+			}
+		}
+
+		autoMerged := 0
+		for _, pr := range prs {
+			if hadAutoMerge(pr) {
+				autoMerged++
+			}
+		}
+		fmt.Printf("\nAuto-merged PRs: %d of %d\n", autoMerged, len(prs))
+
+		forks := 0
+		for _, pr := range prs {
+			if pr.Repository.IsFork {
+				forks++
+			}
+		}
+		fmt.Printf("PRs to forks: %d\n", forks)
+		fmt.Printf("PRs to canonical repos: %d\n", len(prs)-forks)
+
+		archived := 0
+		for _, pr := range prs {
+			if pr.Repository.IsArchived {
+				archived++
+			}
+		}
+		fmt.Printf("PRs to archived repos: %d\n", archived)
+
+		protected := 0
+		for _, pr := range prs {
+			if baseIsProtected(pr) {
+				protected++
+			}
+		}
+		fmt.Printf("Protected branch PRs: %d (%.1f%%)\n", protected, float64(protected)/float64(len(prs))*100)
+		if bypasses := len(bypassPRs(prs)); bypasses > 0 {
+			fmt.Printf("⚠️  Possible review bypasses: %d (merged by someone else with no approvals)\n", bypasses)
+		}
+		selfMerges := len(selfMergedPRs(prs))
+		fmt.Printf("Self-merged PRs: %d (%.1f%%)\n", selfMerges, float64(selfMerges)/float64(len(prs))*100)
+
+		if cfg.fetchesCommits() {
+			withConflicts, baseMerges := 0, 0
+			for _, pr := range prs {
+				if hadConflicts(pr) {
+					withConflicts++
+				}
+				if mergedBaseBranch(pr) {
+					baseMerges++
+				}
+			}
+			fmt.Printf("PRs with a recorded Conflicts: list: %d\n", withConflicts)
+			fmt.Printf("PRs that merged their base branch in: %d\n", baseMerges)
+		}
+
+		withFailures := 0
+		for _, pr := range prs {
+			if _, failed := ciRunCounts(pr); failed > 0 {
+				withFailures++
+			}
+		}
+		fmt.Printf("PRs with CI failures: %d (%.1f%%)\n", withFailures, float64(withFailures)/float64(len(prs))*100)
+
+		if len(cfg.Divergence) > 0 {
+			total := 0
+			for _, behind := range cfg.Divergence {
+				total += behind
+			}
+			fmt.Printf("Branch freshness: head branches were %.1f commits behind their base on average\n",
+				float64(total)/float64(len(cfg.Divergence)))
+		}
+
+		if len(cfg.Workflows) > 0 {
+			failedRuns := 0
+			for _, result := range cfg.Workflows {
+				if result.Conclusion == "failure" {
+					failedRuns++
+				}
+			}
+			fmt.Printf("GitHub Actions failure rate: %.1f%% (%d of %d PRs with workflow runs)\n",
+				float64(failedRuns)/float64(len(cfg.Workflows))*100, failedRuns, len(cfg.Workflows))
+		}
+
+		reopened := 0
+		for _, pr := range prs {
+			if reopenCount(pr) > 0 {
+				reopened++
+			}
+		}
+		fmt.Printf("\nReopened PRs: %d\n", reopened)
+
+		reverts := len(revertPRs(prs))
+		fmt.Printf("Reverts: %d (%.1f%%)\n", reverts, float64(reverts)/float64(len(prs))*100)
+
+		if cfg.BranchPattern != nil {
+			violations := nonCompliantBranches(prs, cfg)
+			fmt.Printf("\nNon-compliant branches: %d (pattern: %s)\n", len(violations), cfg.BranchPattern)
+			for _, pr := range violations {
+				fmt.Printf("  %s#%d: %s\n", repoFullName(pr.Repository), pr.Number, pr.HeadRefName)
+			}
+		}
+
+		if cfg.DetectCoauthors {
+			withCoAuthors := 0
+			for _, pr := range prs {
+				if len(parseCoAuthors(pr)) > 0 {
+					withCoAuthors++
+				}
+			}
+			fmt.Printf("\nPRs with co-authors: %d\n", withCoAuthors)
+		}
+
+		if cfg.DetectCherryPicks {
+			fmt.Printf("\nCherry-picked PRs: %d\n", len(cherryPickPRs(prs)))
+		}
+
+		if cfg.LintCommits {
+			sorted := append([]PullRequest(nil), prs...)
+			sort.SliceStable(sorted, func(i, j int) bool {
+				return commitLintScore(sorted[i]) < commitLintScore(sorted[j])
+			})
+			fmt.Println("\nLowest commit message scores:")
+			for i, pr := range sorted {
+				if i == 5 {
+					break
+				}
+				fmt.Printf("  %s#%d (%.2f): %s\n", repoFullName(pr.Repository), pr.Number, commitLintScore(pr), pr.Title)
+			}
+		}
+	}
+
+	fmt.Printf("\nGraphQL query cost: %d points (%d of %d remaining this hour)\n",
+		tracker.TotalCost, tracker.Remaining, tracker.Limit)
+	fmt.Println(strings.Repeat("=", 60))
+}
+
+// baseBranchCounts returns the number of PRs merged into each base branch
+func baseBranchCounts(prs []PullRequest) map[string]int {
+	counts := make(map[string]int)
+	for _, pr := range prs {
+		counts[pr.BaseRefName]++
+	}
+	return counts
+}
+
+// printBaseBranchChart prints the most common base branches as an ASCII bar chart
+func printBaseBranchChart(counts map[string]int, limit int) {
+	branches := make([]string, 0, len(counts))
+	for branch := range counts {
+		branches = append(branches, branch)
+	}
+	sort.Slice(branches, func(i, j int) bool {
+		if counts[branches[i]] != counts[branches[j]] {
+			return counts[branches[i]] > counts[branches[j]]
+		}
+		return branches[i] < branches[j]
+	})
+	if len(branches) > limit {
+		branches = branches[:limit]
+	}
+	if len(branches) == 0 {
+		return
+	}
+
+	maxCount := counts[branches[0]]
+	fmt.Println("\nTop base branches:")
+	for _, branch := range branches {
+		width := counts[branch] * maxBarWidth / maxCount
+		if width == 0 {
+			width = 1
+		}
+		fmt.Printf("  %-*s %s %d\n", maxBranchNameLength, truncate(branch, maxBranchNameLength),
+			strings.Repeat("█", width), counts[branch])
+	}
+}
+
+// sparkLevels are the block characters used by sparkline, lowest to highest
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders counts as a compact Unicode bar chart, one character per
+// count scaled against the largest. Zero counts use the lowest block and any
+// non-zero count at least the second.
+func sparkline(counts []int) string {
+	maxCount := 0
+	for _, c := range counts {
+		if c > maxCount {
+			maxCount = c
+		}
+	}
+
+	var b strings.Builder
+	for _, c := range counts {
+		level := 0
+		if maxCount > 0 && c > 0 {
+			level = 1 + (c-1)*(len(sparkLevels)-2)/maxCount
+			if c == maxCount {
+				level = len(sparkLevels) - 1
+			}
+		}
+		b.WriteRune(sparkLevels[level])
+	}
+	return b.String()
+}
+
+// prSizeLabels name the size buckets returned by prSizeBucket
+var prSizeLabels = []string{"XS", "S", "M", "L", "XL"}
+
+// prSizeBucket classifies a PR by lines changed: XS < 10, S < 50, M < 250, L < 1000, XL otherwise
+func prSizeBucket(pr PullRequest) int {
+	lines := pr.Additions + pr.Deletions
+	switch {
+	case lines < 10:
+		return 0
+	case lines < 50:
+		return 1
+	case lines < 250:
+		return 2
+	case lines < 1000:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// weeklyMergeCounts returns the number of PRs merged in each week of the date range
+func weeklyMergeCounts(prs []PullRequest) []int {
+	start, err := time.Parse("2006-01-02", mergedStartDate)
+	if err != nil {
+		return nil
+	}
+	counts := make([]int, int(math.Ceil(reportingWeeks())))
+	for _, pr := range prs {
+		if pr.MergedAt == nil {
+			continue
+		}
+		merged, err := time.Parse(time.RFC3339, *pr.MergedAt)
+		if err != nil {
+			continue
+		}
+		week := int(merged.Sub(start).Hours() / (24 * 7))
+		if week >= 0 && week < len(counts) {
+			counts[week]++
+		}
+	}
+	return counts
+}
+
+// WeeklyDeploy counts the PRs merged into the deploy branch in the week starting WeekStart
+type WeeklyDeploy struct {
+	WeekStart string
+	Count     int
+}
+
+// computeDeployFrequency counts PRs merged into baseBranch per repository and
+// week (weeks start on Monday), with each repository's weeks in order
+func computeDeployFrequency(prs []PullRequest, baseBranch string) map[string][]WeeklyDeploy {
+	counts := make(map[string]map[string]int)
+	for _, pr := range prs {
+		if pr.MergedAt == nil || pr.BaseRefName != baseBranch {
+			continue
+		}
+		merged, err := time.Parse(time.RFC3339, *pr.MergedAt)
+		if err != nil {
+			continue
+		}
+		offset := (int(merged.Weekday()) + 6) % 7
+		week := merged.AddDate(0, 0, -offset).Format("2006-01-02")
+
+		repo := repoFullName(pr.Repository)
+		if counts[repo] == nil {
+			counts[repo] = make(map[string]int)
+		}
+		counts[repo][week]++
+	}
+
+	freq := make(map[string][]WeeklyDeploy, len(counts))
+	for repo, weeks := range counts {
+		for week, count := range weeks {
+			freq[repo] = append(freq[repo], WeeklyDeploy{WeekStart: week, Count: count})
+		}
+		sort.Slice(freq[repo], func(i, j int) bool {
+			return freq[repo][i].WeekStart < freq[repo][j].WeekStart
+		})
+	}
+	return freq
+}
+
+// printDeployFrequency prints the mean deploys per week for each repository,
+// counting weeks without a deploy across the whole date range
+func printDeployFrequency(freq map[string][]WeeklyDeploy, baseBranch string) {
+	if len(freq) == 0 {
+		return
+	}
+	repos := make([]string, 0, len(freq))
+	for repo := range freq {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	weeks := reportingWeeks()
+	fmt.Printf("\nDeploy frequency (merges to %s):\n", baseBranch)
+	for _, repo := range repos {
+		total := 0
+		for _, w := range freq[repo] {
+			total += w.Count
+		}
+		fmt.Printf("  %-40s %.2f / week\n", repo, float64(total)/weeks)
+	}
+}
+
+// exportDeployFrequencyToCSV exports weekly deploy counts per repository to a CSV file
+func exportDeployFrequencyToCSV(freq map[string][]WeeklyDeploy, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Repository", "Week Start", "Deploys"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	repos := make([]string, 0, len(freq))
+	for repo := range freq {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	for _, repo := range repos {
+		for _, w := range freq[repo] {
+			if err := writer.Write([]string{repo, w.WeekStart, strconv.Itoa(w.Count)}); err != nil {
+				return fmt.Errorf("failed to write CSV row: %w", err)
+			}
+		}
+	}
+
+	fmt.Printf("✅ Exported weekly deploy frequency for %d repositories to %s\n", len(repos), filename)
+	return nil
+}
+
+// doraMetrics holds the four DORA metrics for the merged PRs, each with its
+// performance level (Elite, High, Medium or Low)
+type doraMetrics struct {
+	DeploysPerWeek          float64  `json:"deploymentsPerWeek"`
+	DeployLevel             string   `json:"deploymentFrequencyLevel"`
+	LeadTimeHours           float64  `json:"leadTimeHours"`
+	LeadTimeLevel           string   `json:"leadTimeLevel"`
+	ChangeFailureRate       float64  `json:"changeFailureRate"`
+	ChangeFailureLevel      string   `json:"changeFailureRateLevel"`
+	MeanTimeToRecoveryHours *float64 `json:"meanTimeToRecoveryHours,omitempty"`
+	RecoveryLevel           string   `json:"meanTimeToRecoveryLevel,omitempty"`
+	Level                   string   `json:"level"`
+}
+
+// doraLevels orders the DORA performance levels from best to worst
+var doraLevels = []string{"Elite", "High", "Medium", "Low"}
+
+// doraLevel returns the level of a metric given the bounds for Elite, High and
+// Medium; higherIsBetter says which side of each bound qualifies
+func doraLevel(value float64, bounds [3]float64, higherIsBetter bool) string {
+	for i, bound := range bounds {
+		if (higherIsBetter && value >= bound) || (!higherIsBetter && value <= bound) {
+			return doraLevels[i]
+		}
+	}
+	return doraLevels[3]
+}
+
+// recoveryHours returns, for each revert, the hours until the next PR that isn't
+// a revert was merged into the same repository, taken as the fix
+func recoveryHours(prs []PullRequest) []float64 {
+	byRepo := make(map[string][]PullRequest)
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			repo := repoFullName(pr.Repository)
+			byRepo[repo] = append(byRepo[repo], pr)
+		}
+	}
+
+	var hours []float64
+	for _, repoPRs := range byRepo {
+		sort.Slice(repoPRs, func(i, j int) bool { return *repoPRs[i].MergedAt < *repoPRs[j].MergedAt })
+		for i, pr := range repoPRs {
+			if !isRevert(pr) {
+				continue
+			}
+			revertedAt, err := time.Parse(time.RFC3339, *pr.MergedAt)
+			if err != nil {
+				continue
+			}
+			for _, next := range repoPRs[i+1:] {
+				if isRevert(next) {
+					continue
+				}
+				if fixedAt, err := time.Parse(time.RFC3339, *next.MergedAt); err == nil {
+					hours = append(hours, fixedAt.Sub(revertedAt).Hours())
+				}
+				break
+			}
+		}
+	}
+	return hours
+}
+
+// computeDORA computes the DORA metrics: merges into baseBranch per week, median
+// hours from PR creation to merge, the share of merges that are reverts, and
+// the mean hours from a revert to the next fix in the same repository
+func computeDORA(prs []PullRequest, baseBranch string) doraMetrics {
+	var m doraMetrics
+
+	deploys := 0
+	for _, weeks := range computeDeployFrequency(prs, baseBranch) {
+		for _, w := range weeks {
+			deploys += w.Count
+		}
+	}
+	m.DeploysPerWeek = float64(deploys) / reportingWeeks()
+	m.DeployLevel = doraLevel(m.DeploysPerWeek, [3]float64{7, 1, 12.0 / 52}, true)
+
+	var leadTimes []float64
+	for _, pr := range prs {
+		if pr.MergedAt == nil {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, pr.CreatedAt)
+		if err != nil {
+			continue
+		}
+		mergedAt, err := time.Parse(time.RFC3339, *pr.MergedAt)
+		if err != nil {
+			continue
+		}
+		leadTimes = append(leadTimes, mergedAt.Sub(createdAt).Hours())
+	}
+	m.LeadTimeHours = percentile(leadTimes, 50)
+	m.LeadTimeLevel = doraLevel(m.LeadTimeHours, [3]float64{24, 24 * 7, 24 * 30}, false)
+
+	if len(prs) > 0 {
+		m.ChangeFailureRate = float64(len(revertPRs(prs))) / float64(len(prs))
+	}
+	m.ChangeFailureLevel = doraLevel(m.ChangeFailureRate, [3]float64{0.05, 0.10, 0.15}, false)
+
+	levels := []string{m.DeployLevel, m.LeadTimeLevel, m.ChangeFailureLevel}
+	if hours := recoveryHours(prs); len(hours) > 0 {
+		total := 0.0
+		for _, h := range hours {
+			total += h
+		}
+		mttr := total / float64(len(hours))
+		m.MeanTimeToRecoveryHours = &mttr
+		m.RecoveryLevel = doraLevel(mttr, [3]float64{1, 24, 24 * 7}, false)
+		levels = append(levels, m.RecoveryLevel)
+	}
+
+	// The overall level is the weakest of the individual metrics
+	m.Level = doraLevels[0]
+	for _, level := range levels {
+		if indexOf(doraLevels, level) > indexOf(doraLevels, m.Level) {
+			m.Level = level
+		}
+	}
+	return m
+}
+
+// indexOf returns the position of s in values, or -1
+func indexOf(values []string, s string) int {
+	for i, v := range values {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// printDORA prints the DORA metrics with their performance levels
+func printDORA(m doraMetrics) {
+	fmt.Printf("\nDORA metrics (overall: %s):\n", m.Level)
+	fmt.Printf("  Deployment frequency:  %.2f / week (%s)\n", m.DeploysPerWeek, m.DeployLevel)
+	fmt.Printf("  Lead time for changes: %.1fh median (%s)\n", m.LeadTimeHours, m.LeadTimeLevel)
+	fmt.Printf("  Change failure rate:   %.1f%% (%s)\n", m.ChangeFailureRate*100, m.ChangeFailureLevel)
+	if m.MeanTimeToRecoveryHours != nil {
+		fmt.Printf("  Mean time to recovery: %.1fh (%s)\n", *m.MeanTimeToRecoveryHours, m.RecoveryLevel)
+	} else {
+		fmt.Println("  Mean time to recovery: no reverts followed by a fix")
+	}
+}
+
+// exportDORAToJSON writes the DORA metrics to a JSON file
+func exportDORAToJSON(m doraMetrics, filename string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+
+	fmt.Printf("✅ Exported DORA metrics to %s\n", filename)
+	return nil
+}
+
+// heatmapLevels encodes heatmap intensity from no merges to the busiest hour
+var heatmapLevels = []rune(" ░▒▓█")
+
+// computeDayHourHeatmap counts merges by weekday (Sunday first) and hour in loc
+func computeDayHourHeatmap(prs []PullRequest, loc *time.Location) [7][24]int {
+	var heatmap [7][24]int
+	for _, pr := range prs {
+		if pr.MergedAt == nil {
+			continue
+		}
+		mergedAt, err := time.Parse(time.RFC3339, *pr.MergedAt)
+		if err != nil {
+			continue
+		}
+		mergedAt = mergedAt.In(loc)
+		heatmap[mergedAt.Weekday()][mergedAt.Hour()]++
+	}
+	return heatmap
+}
+
+// printHeatmap renders merge counts as a weekday by hour grid
+func printHeatmap(heatmap [7][24]int, loc *time.Location) {
+	maxCount := 0
+	for _, row := range heatmap {
+		for _, c := range row {
+			if c > maxCount {
+				maxCount = c
+			}
+		}
+	}
+	if maxCount == 0 {
+		return
+	}
+
+	fmt.Printf("\nMerges by weekday and hour (%s):\n", loc)
+	fmt.Println("      0         1         2   ")
+	fmt.Println("      012345678901234567890123")
+	for day, row := range heatmap {
+		var b strings.Builder
+		for _, c := range row {
+			level := 0
+			if c > 0 {
+				level = 1 + (c-1)*(len(heatmapLevels)-2)/maxCount
+				if c == maxCount {
+					level = len(heatmapLevels) - 1
+				}
+			}
+			b.WriteRune(heatmapLevels[level])
+		}
+		fmt.Printf("  %s %s\n", time.Weekday(day).String()[:3], b.String())
+	}
+}
+
+// exportHeatmapToCSV exports merge counts with one row per weekday and one column per hour
+func exportHeatmapToCSV(heatmap [7][24]int, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Weekday"}
+	for hour := 0; hour < 24; hour++ {
+		header = append(header, fmt.Sprintf("%02d", hour))
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for day, counts := range heatmap {
+		row := []string{time.Weekday(day).String()}
+		for _, c := range counts {
+			row = append(row, strconv.Itoa(c))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported merge heatmap to %s\n", filename)
+	return nil
+}
+
+// printSparklines prints the PR size distribution and weekly merge frequency
+func printSparklines(prs []PullRequest) {
+	sizes := make([]int, len(prSizeLabels))
+	for _, pr := range prs {
+		sizes[prSizeBucket(pr)]++
+	}
+	parts := make([]string, len(prSizeLabels))
+	for i, label := range prSizeLabels {
+		parts[i] = fmt.Sprintf("%s %d", label, sizes[i])
+	}
+	fmt.Printf("\nSize distribution:  %s  (%s)\n", sparkline(sizes), strings.Join(parts, ", "))
+
+	weekly := weeklyMergeCounts(prs)
+	peak := 0
+	for _, c := range weekly {
+		if c > peak {
+			peak = c
+		}
+	}
+	fmt.Printf("Weekly merges:      %s  (peak %d/week)\n", sparkline(weekly), peak)
+}
+
+// authorStats aggregates merged PR activity for a single author
+type authorStats struct {
+	Author    string
+	PRCount   int
+	Additions int
+	Deletions int
+}
+
+// reportingWeeks returns the length of the merged date range in weeks
+func reportingWeeks() float64 {
+	start, err := time.Parse("2006-01-02", mergedStartDate)
+	if err != nil {
+		return 1
+	}
+	end, err := time.Parse("2006-01-02", mergedEndDate)
+	if err != nil {
+		return 1
+	}
+	weeks := end.AddDate(0, 0, 1).Sub(start).Hours() / (24 * 7)
+	if weeks < 1 {
+		return 1
+	}
+	return weeks
+}
+
+// computeOrgStats groups pull requests by author, sorted by PR count descending
+func computeOrgStats(prs []PullRequest) []authorStats {
+	byAuthor := make(map[string]*authorStats)
+	for _, pr := range prs {
+		login := pr.Author.Login
+		if login == "" {
+			login = "ghost"
+		}
+		stats, ok := byAuthor[login]
+		if !ok {
+			stats = &authorStats{Author: login}
+			byAuthor[login] = stats
+		}
+		stats.PRCount++
+		stats.Additions += pr.Additions
+		stats.Deletions += pr.Deletions
+	}
+
+	result := make([]authorStats, 0, len(byAuthor))
+	for _, stats := range byAuthor {
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PRCount != result[j].PRCount {
+			return result[i].PRCount > result[j].PRCount
+		}
+		return result[i].Author < result[j].Author
+	})
+	return result
+}
+
+// printOrgStats displays per-author PR statistics for the organization
+func printOrgStats(org string, stats []authorStats) {
+	weeks := reportingWeeks()
+
+	fmt.Println("\n" + strings.Repeat("=", 100))
+	fmt.Printf("ORG STATS: %s (%d authors)\n", org, len(stats))
+	fmt.Println(strings.Repeat("=", 100))
+	fmt.Printf("%-25s %-10s %-12s %-12s %-14s %-14s\n",
+		"Author", "PRs", "Additions", "Deletions", "Avg PR Size", "PRs / Week")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, st := range stats {
+		avgSize := float64(st.Additions+st.Deletions) / float64(st.PRCount)
+		fmt.Printf("%-25s %-10d %-12d %-12d %-14.1f %-14.2f\n",
+			truncate(st.Author, 25), st.PRCount, st.Additions, st.Deletions,
+			avgSize, float64(st.PRCount)/weeks)
+	}
+
+	fmt.Println(strings.Repeat("=", 100))
+}
+
+// exportOrgStatsToCSV exports per-author PR statistics to a CSV file
+func exportOrgStatsToCSV(stats []authorStats, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{
+		"author", "pr_count", "total_additions", "total_deletions",
+		"avg_pr_size", "avg_prs_per_week",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	weeks := reportingWeeks()
+	for _, st := range stats {
+		avgSize := float64(st.Additions+st.Deletions) / float64(st.PRCount)
+		row := []string{
+			st.Author,
+			fmt.Sprintf("%d", st.PRCount),
+			fmt.Sprintf("%d", st.Additions),
+			fmt.Sprintf("%d", st.Deletions),
+			fmt.Sprintf("%.1f", avgSize),
+			fmt.Sprintf("%.2f", float64(st.PRCount)/weeks),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported stats for %d authors to %s\n", len(stats), filename)
+	return nil
+}
+
+// compactPR is a flattened representation for JSON export
+type compactPR struct {
+	Repository       string   `json:"repository"`
+	Number           int      `json:"number"`
+	Title            string   `json:"title"`
+	Description      string   `json:"description"`
+	URL              string   `json:"url"`
+	Branch           string   `json:"branch"`
+	State            string   `json:"state"`
+	MergedAt         string   `json:"mergedAt"`
+	CreatedAt        string   `json:"createdAt"`
+	UpdatedAt        string   `json:"updatedAt"`
+	Additions        int      `json:"additions"`
+	Deletions        int      `json:"deletions"`
+	ChangedFiles     int      `json:"changedFiles"`
+	Reviews          int      `json:"reviews"`
+	Approved         int      `json:"approved"`
+	ChangesRequested int      `json:"changesRequested"`
+	Commented        int      `json:"commented"`
+	Comments         int      `json:"comments"`
+	Labels           []string `json:"labels,omitempty"`
+	LabelColors      []string `json:"labelColors,omitempty"`
+	Milestone        string   `json:"milestoneTitle,omitempty"`
+	MilestoneDue     string   `json:"milestoneDue,omitempty"`
+	CoAuthors        []string `json:"coAuthors,omitempty"`
+	BranchCompliant  *bool    `json:"branchCompliant,omitempty"`
+	BodyWordCount    int      `json:"bodyWordCount"`
+
+	ReviewToMergeHours       *float64 `json:"reviewToMergeHours,omitempty"`
+	FirstReviewResponseHours *float64 `json:"firstReviewResponseHours,omitempty"`
+	ReviewCoverage           float64  `json:"reviewCoverage"`
+	DraftConversionHours     *float64 `json:"draftConversionHours,omitempty"`
+	AutoMerge                bool     `json:"autoMerge"`
+	AutoMergeMethod          string   `json:"autoMergeMethod,omitempty"`
+	IsFork                   bool     `json:"isFork"`
+	RepoArchived             bool     `json:"repoArchived"`
+	UpstreamRepo             string   `json:"upstreamRepo,omitempty"`
+	AuthorTeams              []string `json:"authorTeams,omitempty"`
+	ReopenCount              int      `json:"reopenCount"`
+	ReviewRequests           int      `json:"reviewRequests"`
+	ReReviewRate             float64  `json:"reReviewRate"`
+	CommitLintScore          *float64 `json:"commitLintScore,omitempty"`
+	LinkedIssues             []int    `json:"linkedIssues,omitempty"`
+
+	InferredMergeMethod string `json:"inferredMergeMethod"`
+	CIStatusAtMerge     string `json:"ciStatusAtMerge,omitempty"`
+	SuggestionsReceived int    `json:"suggestionsReceived"`
+	IsRevert            bool   `json:"isRevert"`
+	BaseIsProtected     bool   `json:"baseIsProtected"`
+	PossibleBypass      bool   `json:"possibleBypass"`
+	SelfMerged          bool   `json:"selfMerged"`
+	CIRunsPassed        int    `json:"ciRunsPassed"`
+	CIRunsFailed        int    `json:"ciRunsFailed"`
+	IsCherryPick        bool   `json:"isCherryPick"`
+	Topic               string `json:"topic"`
+	HadConflicts        *bool  `json:"hadConflicts,omitempty"`
+	MergedBaseBranch    *bool  `json:"mergedBaseBranch,omitempty"`
+	CherryPickSourceSHA string `json:"cherryPickSourceSha,omitempty"`
+
+	ApproximateDivergenceCommits *int `json:"approximateDivergenceCommits,omitempty"`
+
+	WorkflowConclusion      string  `json:"workflowConclusion,omitempty"`
+	WorkflowDurationMinutes float64 `json:"workflowDurationMinutes,omitempty"`
+
+	Assignees          []string `json:"assignees,omitempty"`
+	RequestedReviewers []string `json:"requestedReviewers,omitempty"`
+	BodyMentions       []string `json:"bodyMentions,omitempty"`
+	ProjectBoard       string   `json:"projectBoard,omitempty"`
+	BoardStatus        string   `json:"boardStatus,omitempty"`
+}
+
+// requestedReviewers returns the logins of the users asked to review a PR
+func requestedReviewers(pr PullRequest) []string {
+	var logins []string
+	for _, request := range pr.ReviewRequests.Nodes {
+		if login := request.RequestedReviewer.Login; login != "" {
+			logins = append(logins, login)
+		}
+	}
+	return logins
+}
+
+// reviewers returns the logins with a pending review request on the PR followed
+// by those who submitted a review, since GitHub drops a request once its
+// reviewer responds
+func reviewers(pr PullRequest) []string {
+	logins := requestedReviewers(pr)
+	for _, review := range pr.Reviews.Nodes {
+		if login := review.Author.Login; login != "" {
+			logins = append(logins, login)
+		}
+	}
+	return logins
+}
+
+// bodyMention matches an @login mention that isn't part of an email address,
+// capturing the login and any /team suffix of a team mention
+var bodyMention = regexp.MustCompile(`(?:^|[^\w@.])@([a-zA-Z0-9][a-zA-Z0-9-]*)(/[\w-]+)?`)
+
+// bodyMentions returns the unique logins @-mentioned in the PR description,
+// ignoring team mentions such as @org/team
+func bodyMentions(pr PullRequest) []string {
+	seen := make(map[string]bool)
+	var logins []string
+	for _, match := range bodyMention.FindAllStringSubmatch(pr.Body, -1) {
+		if match[2] != "" || seen[strings.ToLower(match[1])] {
+			continue
+		}
+		seen[strings.ToLower(match[1])] = true
+		logins = append(logins, match[1])
+	}
+	return logins
+}
+
+// printMostMentioned prints the five logins mentioned in the most PR descriptions
+func printMostMentioned(prs []PullRequest) {
+	counts := make(map[string]int)
+	for _, pr := range prs {
+		for _, login := range bodyMentions(pr) {
+			counts[strings.ToLower(login)]++
+		}
+	}
+	if len(counts) == 0 {
+		return
+	}
+	logins := make([]string, 0, len(counts))
+	for login := range counts {
+		logins = append(logins, login)
+	}
+	sort.Slice(logins, func(i, j int) bool {
+		if counts[logins[i]] != counts[logins[j]] {
+			return counts[logins[i]] > counts[logins[j]]
+		}
+		return logins[i] < logins[j]
+	})
+
+	fmt.Println("\nMost mentioned colleagues:")
+	for i := 0; i < len(logins) && i < 5; i++ {
+		fmt.Printf("  @%s: %d PRs\n", logins[i], counts[logins[i]])
+	}
+}
+
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// assigneeLogins returns the logins of the users assigned to a PR
+func assigneeLogins(pr PullRequest) []string {
+	logins := make([]string, len(pr.Assignees.Nodes))
+	for i, user := range pr.Assignees.Nodes {
+		logins[i] = user.Login
+	}
+	return logins
+}
+
+// toCompactPRs flattens pull requests into their compact export representation
+func toCompactPRs(prs []PullRequest, cfg *Config) []compactPR {
+	compact := make([]compactPR, len(prs))
+	for i, pr := range prs {
+		labels := make([]string, len(pr.Labels.Nodes))
+		labelColors := make([]string, len(pr.Labels.Nodes))
+		for j, l := range pr.Labels.Nodes {
+			labels[j] = l.Name
+			labelColors[j] = l.Color
+		}
+
+		var milestone, milestoneDue string
+		if pr.Milestone != nil {
+			milestone = pr.Milestone.Title
+			milestoneDue = formatDate(pr.Milestone.DueOn)
+		}
+
+		compact[i] = compactPR{
+			Repository:       repoFullName(pr.Repository),
+			Description:      pr.Body,
+			Number:           pr.Number,
+			Title:            pr.Title,
+			URL:              pr.URL,
+			Branch:           pr.HeadRefName,
+			State:            pr.State,
+			MergedAt:         formatDate(pr.MergedAt),
+			CreatedAt:        formatDateString(pr.CreatedAt),
+			UpdatedAt:        formatDateString(pr.UpdatedAt),
+			Additions:        pr.Additions,
+			Deletions:        pr.Deletions,
+			ChangedFiles:     pr.ChangedFiles,
+			Reviews:          pr.Reviews.TotalCount,
+			Approved:         pr.Reviews.Approved,
+			ChangesRequested: pr.Reviews.ChangesRequested,
+			Commented:        pr.Reviews.Commented,
+			Comments:         pr.Comments.TotalCount,
+			Labels:           labels,
+			LabelColors:      labelColors,
+			Milestone:        milestone,
+			MilestoneDue:     milestoneDue,
+			CoAuthors:        parseCoAuthors(pr),
+			BodyWordCount:    bodyWordCount(pr),
+
+			ReviewToMergeHours: reviewToMergeHours(pr),
+		}
+
+		if cfg.BranchPattern != nil {
+			compliant := branchCompliant(pr, cfg)
+			compact[i].BranchCompliant = &compliant
+		}
+
+		compact[i].AuthorTeams = cfg.AuthorTeams[pr.Author.Login]
+		if behind, ok := cfg.Divergence[fmt.Sprintf("%s#%d", repoFullName(pr.Repository), pr.Number)]; ok {
+			compact[i].ApproximateDivergenceCommits = &behind
+		}
+		if result, ok := cfg.Workflows[fmt.Sprintf("%s#%d", repoFullName(pr.Repository), pr.Number)]; ok {
+			compact[i].WorkflowConclusion = result.Conclusion
+			compact[i].WorkflowDurationMinutes = result.DurationMinutes
+		}
+		compact[i].ReopenCount = reopenCount(pr)
+		compact[i].ReviewRequests = pr.ReviewRequests.TotalCount
+		compact[i].ReReviewRate = reReviewRate(pr)
+		compact[i].LinkedIssues = linkedIssueNumbers(pr)
+		compact[i].InferredMergeMethod = inferMergeMethod(pr)
+		compact[i].CIStatusAtMerge = ciStatusAtMerge(pr)
+		compact[i].SuggestionsReceived = suggestionsReceived(pr)
+		compact[i].Assignees = assigneeLogins(pr)
+		compact[i].RequestedReviewers = requestedReviewers(pr)
+		compact[i].BodyMentions = bodyMentions(pr)
+		compact[i].FirstReviewResponseHours = firstReviewResponseHours(pr)
+		compact[i].ReviewCoverage = reviewCoverage(pr)
+		compact[i].DraftConversionHours = draftConversionHours(pr)
+		if len(pr.ProjectItems.Nodes) > 0 {
+			item := pr.ProjectItems.Nodes[0]
+			compact[i].ProjectBoard = item.Project.Title
+			if item.Status != nil {
+				compact[i].BoardStatus = item.Status.Name
+			}
+		}
+		compact[i].IsRevert = isRevert(pr)
+		compact[i].BaseIsProtected = baseIsProtected(pr)
+		compact[i].PossibleBypass = possibleBypass(pr)
+		compact[i].SelfMerged = selfMerged(pr)
+		compact[i].CIRunsPassed, compact[i].CIRunsFailed = ciRunCounts(pr)
+		compact[i].CherryPickSourceSHA = cherryPickSource(pr)
+		compact[i].IsCherryPick = compact[i].CherryPickSourceSHA != ""
+		if cfg.fetchesCommits() {
+			conflicts, baseMerge := hadConflicts(pr), mergedBaseBranch(pr)
+			compact[i].HadConflicts = &conflicts
+			compact[i].MergedBaseBranch = &baseMerge
+		}
+		compact[i].Topic = classifyPR(pr, cfg.TopicPatterns)
+		if cfg.LintCommits {
+			score := commitLintScore(pr)
+			compact[i].CommitLintScore = &score
+		}
+		compact[i].IsFork = pr.Repository.IsFork
+		compact[i].RepoArchived = pr.Repository.IsArchived
+		if parent := pr.Repository.Parent; parent != nil {
+			compact[i].UpstreamRepo = parent.Owner.Login + "/" + parent.Name
+		}
+
+		compact[i].AutoMerge = hadAutoMerge(pr)
+		if pr.AutoMergeRequest != nil {
+			compact[i].AutoMergeMethod = pr.AutoMergeRequest.MergeMethod
+		}
+	}
+	return compact
+}
+
+// exportToJSON exports pull requests to a JSON file
+func exportToJSON(prs []PullRequest, filename string, cfg *Config) error {
+	data, err := json.MarshalIndent(toCompactPRs(prs, cfg), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+
+	fmt.Printf("✅ Exported %d pull requests to %s\n", len(prs), filename)
+	return nil
+}
+
+// exportToCSV exports pull requests to a CSV file
+func exportToCSV(prs []PullRequest, filename string, cfg *Config) error {
+	if len(prs) == 0 {
+		fmt.Println("No pull requests to export")
+		return nil
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	if err := writeCSV(file, prs, cfg); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Exported %d pull requests to %s\n", len(prs), filename)
+	return nil
+}
+
+// compactFieldIndex maps each compactPR JSON tag name to its struct field index
+func compactFieldIndex() map[string]int {
+	t := reflect.TypeOf(compactPR{})
+	index := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			index[name] = i
+		}
+	}
+	return index
+}
+
+// parseFields splits a comma-separated --fields value and checks each name
+// against the compactPR JSON tags
+func parseFields(value string) ([]string, error) {
+	index := compactFieldIndex()
+	var fields, unknown []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := index[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		fields = append(fields, name)
+	}
+
+	if len(unknown) > 0 {
+		valid := make([]string, 0, len(index))
+		for name := range index {
+			valid = append(valid, name)
+		}
+		sort.Strings(valid)
+		return nil, fmt.Errorf("unknown field(s): %s (valid fields: %s)", strings.Join(unknown, ", "), strings.Join(valid, ", "))
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields given")
+	}
+	return fields, nil
+}
+
+// csvValue formats a compact field for a CSV cell: lists are comma-joined,
+// maps become sorted key=value pairs and nil pointers are empty
+func csvValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+		return csvValue(v.Elem())
+	case reflect.Slice:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = csvValue(v.Index(i))
+		}
+		return strings.Join(parts, ", ")
+	case reflect.Map:
+		parts := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			parts = append(parts, fmt.Sprintf("%s=%s", csvValue(key), csvValue(v.MapIndex(key))))
+		}
+		sort.Strings(parts)
+		return strings.Join(parts, "; ")
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// writeFieldsCSV writes only the requested compact fields, in order, using the
+// JSON tag names as the header
+func writeFieldsCSV(w io.Writer, records []compactPR, fields []string) error {
+	writer := csv.NewWriter(w)
+	index := compactFieldIndex()
+
+	if err := writer.Write(fields); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, record := range records {
+		v := reflect.ValueOf(record)
+		row := make([]string, len(fields))
+		for i, name := range fields {
+			row[i] = csvValue(v.Field(index[name]))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
+
+// writeCSV writes the CSV header and one row per pull request to w.
+// With --fields, only the selected compactPR fields are written.
+func writeCSV(w io.Writer, prs []PullRequest, cfg *Config) error {
+	if len(cfg.Fields) > 0 {
+		return writeFieldsCSV(w, toCompactPRs(prs, cfg), cfg.Fields)
+	}
+
+	writer := csv.NewWriter(w)
+
+	header := []string{
+		"Repository", "PR#", "Title", "URL", "Branch", "State",
+		"Merged At", "Created At", "Updated At",
+		"Additions", "Deletions", "Changed Files",
+		"Reviews", "Comments", "Labels", "Label Colors",
+		"Milestone", "Milestone Due", "Assignees", "Requested Reviewers",
+		"Mentions",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, pr := range prs {
+		labels := make([]string, len(pr.Labels.Nodes))
+		labelColors := make([]string, len(pr.Labels.Nodes))
+		for i, l := range pr.Labels.Nodes {
+			labels[i] = l.Name
+			labelColors[i] = l.Color
+		}
+		labelsStr := strings.Join(labels, "; ")
+
+		milestone, milestoneDue := "N/A", "N/A"
+		if pr.Milestone != nil {
+			milestone = pr.Milestone.Title
+			milestoneDue = formatDate(pr.Milestone.DueOn)
+		}
+
+		row := []string{
+			repoFullName(pr.Repository),
+			fmt.Sprintf("%d", pr.Number),
+			pr.Title,
+			pr.URL,
+			pr.HeadRefName,
+			pr.State,
+			formatDate(pr.MergedAt),
+			formatDateString(pr.CreatedAt),
+			formatDateString(pr.UpdatedAt),
+			fmt.Sprintf("%d", pr.Additions),
+			fmt.Sprintf("%d", pr.Deletions),
+			fmt.Sprintf("%d", pr.ChangedFiles),
+			fmt.Sprintf("%d", pr.Reviews.TotalCount),
+			fmt.Sprintf("%d", pr.Comments.TotalCount),
+			labelsStr,
+			strings.Join(labelColors, "; "),
+			milestone,
+			milestoneDue,
+			strings.Join(assigneeLogins(pr), "; "),
+			strings.Join(requestedReviewers(pr), "; "),
+			strings.Join(bodyMentions(pr), "; "),
+		}
+
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
+
+// markdownAnchor converts a heading into an HTML anchor id
+func markdownAnchor(heading string) string {
+	var b strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(heading) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastDash = false
+		} else if !lastDash {
+			b.WriteByte('-')
+			lastDash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// markdownCell escapes a value for use inside a Markdown table cell
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// exportToMarkdown exports pull requests to a Markdown file grouped by repository
+func exportToMarkdown(prs []PullRequest, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create Markdown file: %w", err)
+	}
+	defer file.Close()
+
+	if err := writeMarkdown(file, prs); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Exported %d pull requests to %s\n", len(prs), filename)
+	return nil
+}
+
+// writeMarkdown writes pull requests grouped by repository, preceded by a table
+// of contents linking to an HTML anchor on each repository heading
+func writeMarkdown(w io.Writer, prs []PullRequest) error {
+	byRepo := make(map[string][]PullRequest)
+	var repos []string
+	for _, pr := range prs {
+		repo := repoFullName(pr.Repository)
+		if _, ok := byRepo[repo]; !ok {
+			repos = append(repos, repo)
+		}
+		byRepo[repo] = append(byRepo[repo], pr)
+	}
+	sort.Strings(repos)
+
+	var b strings.Builder
+	b.WriteString("# Merged Pull Requests\n\n")
+	b.WriteString("## Contents\n\n")
+	for _, repo := range repos {
+		fmt.Fprintf(&b, "- [%s](#%s) (%d)\n", repo, markdownAnchor(repo), len(byRepo[repo]))
+	}
+
+	for _, repo := range repos {
+		fmt.Fprintf(&b, "\n<a id=\"%s\"></a>\n\n## %s\n\n", markdownAnchor(repo), repo)
+		b.WriteString("| PR | Title | Branch | Merged At | +/- |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, pr := range byRepo[repo] {
+			fmt.Fprintf(&b, "| [#%d](%s) | %s | %s | %s | +%d/-%d |\n",
+				pr.Number, pr.URL, markdownCell(pr.Title), markdownCell(pr.HeadRefName),
+				formatDate(pr.MergedAt), pr.Additions, pr.Deletions)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write Markdown: %w", err)
+	}
+	return nil
+}
+
+// printPreview prints the first few pull requests in each export format to stdout
+func printPreview(prs []PullRequest, cfg *Config) error {
+	sample := prs
+	if len(sample) > previewRecordLimit {
+		sample = sample[:previewRecordLimit]
+	}
+
+	fmt.Printf("\n🔍 Preview of %d of %d pull requests (no files written)\n", len(sample), len(prs))
+
+	fmt.Println("\n--- JSON ---")
+	data, err := json.MarshalIndent(toCompactPRs(sample, cfg), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+
+	fmt.Println("\n--- CSV ---")
+	if err := writeCSV(os.Stdout, sample, cfg); err != nil {
+		return err
+	}
+
+	fmt.Println("\n--- Markdown ---")
+	return writeMarkdown(os.Stdout, sample)
+}
+
+// getOrganizations fetches the organizations visible to the token
+func getOrganizations(token string) ([]Organization, error) {
+	query := `
+	query GetOrganizations {
+		viewer {
+			login
+			organizations(first: 100) {
+				nodes {
+					login
+					name
+				}
+			}
+		}
+	}
+	`
+
+	resp, err := makeGraphQLRequest(token, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organizations: %w", err)
+	}
+	return resp.Data.Viewer.Organizations.Nodes, nil
+}
+
+// runOrgs lists the organizations the token can see, for use with --org
+func runOrgs(token string) {
+	orgs, err := getOrganizations(token)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(orgs) == 0 {
+		fmt.Println("No organizations found. The token may need the read:org scope.")
+		return
+	}
+
+	fmt.Printf("%-30s %s\n", "Login (use with --org)", "Name")
+	fmt.Println(strings.Repeat("-", 60))
+	for _, org := range orgs {
+		fmt.Printf("%-30s %s\n", org.Login, org.Name)
+	}
+}
+
+// MemberStats aggregates the merged PRs of one organization member
+type MemberStats struct {
+	Login     string
+	PRs       int
+	Additions int
+	Deletions int
+	Err       error
+}
+
+// getOrgMembers fetches the logins of an organization's members
+func getOrgMembers(token, org string) ([]string, error) {
+	query := `
+	query GetOrgMembers($org: String!, $after: String) {
+		organization(login: $org) {
+			membersWithRole(first: 100, after: $after) {
+				nodes {
+					login
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+	`
+
+	var logins []string
+	var after *string
+	for {
+		resp, err := makeGraphQLRequest(token, query, map[string]interface{}{"org": org, "after": after})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch members of %s: %w", org, err)
+		}
+		if resp.Data.Organization == nil {
+			return nil, fmt.Errorf("organization %s not found", org)
+		}
+
+		members := resp.Data.Organization.MembersWithRole
+		for _, node := range members.Nodes {
+			logins = append(logins, node.Login)
+		}
+		if !members.PageInfo.HasNextPage {
+			break
+		}
+		after = members.PageInfo.EndCursor
+	}
+	return logins, nil
+}
+
+// getMemberStats totals the PRs a member merged into the organization during the date range
+func getMemberStats(token, org, login string) MemberStats {
+	query := `
+	query MemberPRs($queryString: String!, $after: String) {
+		search(query: $queryString, type: ISSUE, first: 100, after: $after) {
+			issueCount
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					... on PullRequest {
+						additions
+						deletions
+					}
+				}
+			}
+		}
+	}
+	`
+
+	stats := MemberStats{Login: login}
+	searchQuery := fmt.Sprintf("is:pr author:%s org:%s is:merged merged:%s..%s", login, org, mergedStartDate, mergedEndDate)
+	var after *string
+	for {
+		resp, err := makeGraphQLRequest(token, query, map[string]interface{}{"queryString": searchQuery, "after": after})
+		if err != nil {
+			stats.Err = fmt.Errorf("failed to fetch PRs for %s: %w", login, err)
+			return stats
+		}
+
+		stats.PRs = resp.Data.Search.IssueCount
+		for _, edge := range resp.Data.Search.Edges {
+			stats.Additions += edge.Node.Additions
+			stats.Deletions += edge.Node.Deletions
+		}
+		if !resp.Data.Search.PageInfo.HasNextPage {
+			break
+		}
+		after = resp.Data.Search.PageInfo.EndCursor
+	}
+	return stats
+}
+
+// exportOrgReportToCSV exports per-member merged PR totals to a CSV file
+func exportOrgReportToCSV(stats []MemberStats, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"Login", "Merged PRs", "Additions", "Deletions"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, s := range stats {
+		row := []string{
+			s.Login,
+			strconv.Itoa(s.PRs),
+			strconv.Itoa(s.Additions),
+			strconv.Itoa(s.Deletions),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	fmt.Printf("✅ Exported stats for %d members to %s\n", len(stats), filename)
+	return nil
+}
+
+// runOrgReport searches the merged PRs of every member of an organization in
+// parallel and exports the per-member totals. Listing members needs read:org.
+func runOrgReport(token string, args []string) {
+	fs := flag.NewFlagSet("org-report", flag.ExitOnError)
+	org := fs.String("org", "", "organization to report on (required)")
+	concurrency := fs.Int("concurrency", defaultOrgReportConcurrency, "number of member searches to run in parallel")
+	fs.Parse(args)
+
+	if *org == "" {
+		fmt.Println("❌ Error: org-report requires --org")
+		os.Exit(1)
+	}
+	if *concurrency < 1 {
+		fmt.Println("❌ Error: --concurrency must be at least 1")
+		os.Exit(1)
+	}
+
+	members, err := getOrgMembers(token, *org)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		fmt.Println("Listing organization members requires a token with the read:org scope.")
+		os.Exit(1)
+	}
+
+	fmt.Printf("👥 Searching merged PRs for %d members of %s (%s to %s)\n", len(members), *org, startDateDisplay, endDateDisplay)
+
+	stats := make([]MemberStats, len(members))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, *concurrency)
+	for i, login := range members {
+		wg.Add(1)
+		go func(i int, login string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			stats[i] = getMemberStats(token, *org, login)
+		}(i, login)
+	}
+	wg.Wait()
+
+	var report []MemberStats
+	for _, s := range stats {
+		if s.Err != nil {
+			fmt.Printf("⚠️  %v\n", s.Err)
+			continue
+		}
+		report = append(report, s)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].PRs != report[j].PRs {
+			return report[i].PRs > report[j].PRs
+		}
+		return report[i].Login < report[j].Login
+	})
+
+	fmt.Printf("\n%-30s %10s %10s %10s\n", "Member", "PRs", "Additions", "Deletions")
+	fmt.Println(strings.Repeat("-", 63))
+	for _, s := range report {
+		fmt.Printf("%-30s %10d %10d %10d\n", s.Login, s.PRs, s.Additions, s.Deletions)
+	}
+
+	if err := exportOrgReportToCSV(report, "org_contribution_report.csv"); err != nil {
+		fmt.Printf("❌ Error exporting org report CSV: %v\n", err)
+	}
+}
+
+// getCollaborators fetches up to ten collaborator logins for a repository.
+// Listing collaborators needs push access, so repositories the token cannot
+// administer return an error.
+func getCollaborators(token string, repo Repository) ([]string, error) {
+	query := `
+	query GetCollaborators($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			collaborators(first: 10) {
+				nodes {
+					login
+				}
+			}
+		}
+	}
+	`
+
+	variables := map[string]interface{}{
+		"owner": repo.Owner.Login,
+		"name":  repo.Name,
+	}
+
+	resp, err := makeGraphQLRequest(token, query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch collaborators for %s: %w", repoFullName(repo), err)
+	}
+	if resp.Data.Repository == nil {
+		return nil, nil
+	}
+
+	logins := make([]string, len(resp.Data.Repository.Collaborators.Nodes))
+	for i, node := range resp.Data.Repository.Collaborators.Nodes {
+		logins[i] = node.Login
+	}
+	return logins, nil
+}
+
+// fetchCollaborators queries the collaborators of every repository in prs once,
+// in parallel, keyed by full repository name
+func fetchCollaborators(token string, prs []PullRequest) map[string][]string {
+	var queried, results sync.Map
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, collaboratorFetchConcurrency)
+
+	for _, pr := range prs {
+		repo := pr.Repository
+		if _, loaded := queried.LoadOrStore(repoFullName(repo), true); loaded {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			logins, err := getCollaborators(token, repo)
+			if err != nil {
+				fmt.Printf("⚠️  %v\n", err)
+				return
+			}
+			results.Store(repoFullName(repo), logins)
+		}()
+	}
+	wg.Wait()
+
+	collaborators := make(map[string][]string)
+	results.Range(func(key, value interface{}) bool {
+		collaborators[key.(string)] = value.([]string)
+		return true
+	})
+	return collaborators
+}
+
+// writeDOT writes an undirected Graphviz graph with one node per repository and
+// an edge between two repositories for each contributor they share. The authors
+// of the extracted PRs are left out, since they link every repository they merged into.
+func writeDOT(w io.Writer, prs []PullRequest, collaborators map[string][]string) error {
+	prCounts := make(map[string]int)
+	authors := make(map[string]bool)
+	for _, pr := range prs {
+		prCounts[repoFullName(pr.Repository)]++
+		authors[pr.Author.Login] = true
+	}
+
+	repos := make([]string, 0, len(prCounts))
+	for repo := range prCounts {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	contributors := make(map[string]map[string]bool, len(repos))
+	for _, repo := range repos {
+		contributors[repo] = make(map[string]bool)
+		for _, login := range collaborators[repo] {
+			if !authors[login] {
+				contributors[repo][login] = true
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("graph contributors {\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, repo := range repos {
+		fmt.Fprintf(&b, "\t%q [label=%q];\n", repo, fmt.Sprintf("%s\n%d PRs", repo, prCounts[repo]))
+	}
+	for i, a := range repos {
+		for _, other := range repos[i+1:] {
+			var shared []string
+			for login := range contributors[a] {
+				if contributors[other][login] {
+					shared = append(shared, login)
+				}
+			}
+			if len(shared) == 0 {
+				continue
+			}
+			sort.Strings(shared)
+			fmt.Fprintf(&b, "\t%q -- %q [label=%q];\n", a, other, strings.Join(shared, ", "))
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// exportToDOT writes the repository contributor graph to a DOT file
+func exportToDOT(token string, prs []PullRequest, filename string) error {
+	collaborators := fetchCollaborators(token, prs)
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create DOT file: %w", err)
+	}
+	defer file.Close()
+
+	if err := writeDOT(file, prs, collaborators); err != nil {
+		return fmt.Errorf("failed to write DOT file: %w", err)
+	}
+
+	fmt.Printf("✅ Exported contributor graph to %s\n", filename)
+	return nil
+}
+
+// repoRank compares the viewer's merged PRs in a repository with its contributor count
+type repoRank struct {
+	Repo         string
+	MyPRs        int
+	Contributors int
+}
+
+// rankEstimate is merged PRs per contributor; above 1 suggests more activity
+// than an average contributor to the repository
+func (r repoRank) rankEstimate() float64 {
+	if r.Contributors == 0 {
+		return 0
+	}
+	return float64(r.MyPRs) / float64(r.Contributors)
+}
+
+// getMentionableUserCount fetches the number of users who can be mentioned in a
+// repository, used as an approximation of its contributor count
+func getMentionableUserCount(token string, repo Repository) (int, error) {
+	query := `
+	query GetMentionableUsers($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			mentionableUsers(first: 1) {
+				totalCount
+			}
+		}
+	}
+	`
+
+	variables := map[string]interface{}{
+		"owner": repo.Owner.Login,
+		"name":  repo.Name,
+	}
+
+	resp, err := makeGraphQLRequest(token, query, variables)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch contributors for %s: %w", repoFullName(repo), err)
+	}
+	if resp.Data.Repository == nil {
+		return 0, nil
+	}
+	return resp.Data.Repository.MentionableUsers.TotalCount, nil
+}
+
+// computeContributorRanks counts merged PRs per repository and fetches each
+// repository's approximate contributor count, ordered by PR count
+func computeContributorRanks(token string, prs []PullRequest) []repoRank {
+	counts := make(map[string]int)
+	repos := make(map[string]Repository)
+	for _, pr := range prs {
+		name := repoFullName(pr.Repository)
+		counts[name]++
+		repos[name] = pr.Repository
+	}
+
+	ranks := make([]repoRank, 0, len(counts))
+	for name, count := range counts {
+		contributors, err := getMentionableUserCount(token, repos[name])
+		if err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+		}
+		ranks = append(ranks, repoRank{Repo: name, MyPRs: count, Contributors: contributors})
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if ranks[i].MyPRs != ranks[j].MyPRs {
+			return ranks[i].MyPRs > ranks[j].MyPRs
+		}
+		return ranks[i].Repo < ranks[j].Repo
+	})
+	return ranks
 }
 
-// printPRsTable displays pull requests in a formatted console table
-func printPRsTable(prs []PullRequest) {
-	if len(prs) == 0 {
-		fmt.Println("\nNo pull requests found.")
-		return
+// exportContributorRankToCSV exports per-repository contributor ranks to a CSV file
+func exportContributorRankToCSV(ranks []repoRank, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
 	}
+	defer file.Close()
 
-	fmt.Println("\n" + strings.Repeat("=", 135))
-	fmt.Printf("%-30s %-7s %-42s %-25s %-18s %-10s\n",
-		"Repo", "PR#", "Title", "Branch", "Merged At", "+/-")
-	fmt.Println(strings.Repeat("=", 135))
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
 
-	for _, pr := range prs {
-		repo := truncate(repoFullName(pr.Repository), 30)
-		title := truncate(pr.Title, 42)
-		branch := truncate(pr.HeadRefName, 25)
-		mergedAt := formatDate(pr.MergedAt)
-		changes := fmt.Sprintf("+%d/-%d", pr.Additions, pr.Deletions)
+	header := []string{"repo", "my_prs", "approx_total_contributors", "rank_estimate"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
 
-		fmt.Printf("%-30s %-7d %-42s %-25s %-18s %-10s\n",
-			repo, pr.Number, title, branch, mergedAt, changes)
+	for _, r := range ranks {
+		row := []string{
+			r.Repo,
+			fmt.Sprintf("%d", r.MyPRs),
+			fmt.Sprintf("%d", r.Contributors),
+			fmt.Sprintf("%.2f", r.rankEstimate()),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
 	}
 
-	fmt.Println(strings.Repeat("=", 135))
+	fmt.Printf("✅ Exported contributor ranks for %d repositories to %s\n", len(ranks), filename)
+	return nil
 }
 
-// printSummary displays summary statistics about the pull requests
-func printSummary(prs []PullRequest) {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("SUMMARY")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Printf("Total merged PRs: %d\n", len(prs))
-	fmt.Printf("Date range: %s - %s\n", startDateDisplay, endDateDisplay)
+// slackMessage is the request body for Slack's chat.postMessage
+type slackMessage struct {
+	Channel  string       `json:"channel"`
+	Text     string       `json:"text"`
+	Blocks   []slackBlock `json:"blocks,omitempty"`
+	ThreadTS string       `json:"thread_ts,omitempty"`
+}
 
-	if len(prs) > 0 {
-		repos := make(map[string]int)
-		totalAdditions := 0
-		totalDeletions := 0
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
 
-		for _, pr := range prs {
-			repos[repoFullName(pr.Repository)]++
-			totalAdditions += pr.Additions
-			totalDeletions += pr.Deletions
-		}
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
 
-		fmt.Println("\nPRs by repository:")
-		for repo, count := range repos {
-			fmt.Printf("  %s: %d\n", repo, count)
-		}
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	TS    string `json:"ts"`
+}
 
-		fmt.Printf("\nTotal lines added:   +%d\n", totalAdditions)
-		fmt.Printf("Total lines deleted: -%d\n", totalDeletions)
+// slackPoster posts messages to a Slack channel, spacing them to respect
+// the Tier-1 limit of one message per second
+type slackPoster struct {
+	token    string
+	channel  string
+	lastPost time.Time
+}
+
+// post sends a message and returns its timestamp, for use as a thread parent
+func (p *slackPoster) post(text string, blocks []slackBlock, threadTS string) (string, error) {
+	if wait := slackPostInterval - time.Since(p.lastPost); wait > 0 {
+		time.Sleep(wait)
 	}
+	p.lastPost = time.Now()
 
-	fmt.Println(strings.Repeat("=", 60))
+	jsonBody, err := json.Marshal(slackMessage{
+		Channel:  p.channel,
+		Text:     text,
+		Blocks:   blocks,
+		ThreadTS: threadTS,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Slack message: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", slackPostMessageURL, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create Slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send Slack request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Slack response: %w", err)
+	}
+
+	var slackResp slackResponse
+	if err := json.Unmarshal(body, &slackResp); err != nil {
+		return "", fmt.Errorf("failed to unmarshal Slack response: %w", err)
+	}
+	if !slackResp.OK {
+		return "", fmt.Errorf("Slack API error: %s", slackResp.Error)
+	}
+	return slackResp.TS, nil
 }
 
-// compactPR is a flattened representation for JSON export
-type compactPR struct {
-	Repository   string   `json:"repository"`
-	Number       int      `json:"number"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	URL          string   `json:"url"`
-	Branch       string   `json:"branch"`
-	State        string   `json:"state"`
-	MergedAt     string   `json:"mergedAt"`
-	CreatedAt    string   `json:"createdAt"`
-	UpdatedAt    string   `json:"updatedAt"`
-	Additions    int      `json:"additions"`
-	Deletions    int      `json:"deletions"`
-	ChangedFiles int      `json:"changedFiles"`
-	Reviews      int      `json:"reviews"`
-	Comments     int      `json:"comments"`
-	Labels       []string `json:"labels,omitempty"`
+// mrkdwn returns a Slack mrkdwn text object
+func mrkdwn(text string) slackText {
+	return slackText{Type: "mrkdwn", Text: text}
 }
 
-// exportToJSON exports pull requests to a JSON file
-func exportToJSON(prs []PullRequest, filename string) error {
-	compact := make([]compactPR, len(prs))
-	for i, pr := range prs {
-		labels := make([]string, len(pr.Labels.Nodes))
-		for j, l := range pr.Labels.Nodes {
-			labels[j] = l.Name
+// prSlackBlocks renders a pull request as Slack blocks
+func prSlackBlocks(pr PullRequest) []slackBlock {
+	title := mrkdwn(fmt.Sprintf("*<%s|%s#%d>* %s", pr.URL, repoFullName(pr.Repository), pr.Number, pr.Title))
+	return []slackBlock{
+		{Type: "section", Text: &title},
+		{Type: "section", Fields: []slackText{
+			mrkdwn("*Author:* " + pr.Author.Login),
+			mrkdwn("*Merged:* " + formatDate(pr.MergedAt)),
+			mrkdwn(fmt.Sprintf("*Changes:* +%d/-%d", pr.Additions, pr.Deletions)),
+			mrkdwn(fmt.Sprintf("*Reviews:* %d", pr.Reviews.TotalCount)),
+		}},
+	}
+}
+
+// exportToSlack posts PRs to a Slack channel. By default each PR is its own
+// message; with a batch size, a summary message is posted and the PRs follow
+// as thread replies of up to that many PRs each.
+func exportToSlack(prs []PullRequest, token string, cfg *Config) error {
+	poster := &slackPoster{token: token, channel: cfg.SlackChannel}
+
+	if cfg.SlackBatchSize <= 0 {
+		for _, pr := range prs {
+			text := fmt.Sprintf("%s#%d %s", repoFullName(pr.Repository), pr.Number, pr.Title)
+			if _, err := poster.post(text, prSlackBlocks(pr), ""); err != nil {
+				return err
+			}
 		}
+		fmt.Printf("✅ Posted %d PRs to Slack channel %s\n", len(prs), cfg.SlackChannel)
+		return nil
+	}
 
-		compact[i] = compactPR{
-			Repository:   repoFullName(pr.Repository),
-			Description:  pr.Body,
-			Number:       pr.Number,
-			Title:        pr.Title,
-			URL:          pr.URL,
-			Branch:       pr.HeadRefName,
-			State:        pr.State,
-			MergedAt:     formatDate(pr.MergedAt),
-			CreatedAt:    formatDateString(pr.CreatedAt),
-			UpdatedAt:    formatDateString(pr.UpdatedAt),
-			Additions:    pr.Additions,
-			Deletions:    pr.Deletions,
-			ChangedFiles: pr.ChangedFiles,
-			Reviews:      pr.Reviews.TotalCount,
-			Comments:     pr.Comments.TotalCount,
-			Labels:       labels,
-		}
-	}
-
-	data, err := json.MarshalIndent(compact, "", "  ")
+	batchSize := cfg.SlackBatchSize
+
+	summary := fmt.Sprintf("🔀 %d pull requests merged between %s and %s", len(prs), startDateDisplay, endDateDisplay)
+	summaryText := mrkdwn("*" + summary + "*")
+	threadTS, err := poster.post(summary, []slackBlock{{Type: "section", Text: &summaryText}}, "")
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+		return err
 	}
 
-	if err := os.WriteFile(filename, data, 0644); err != nil {
-		return fmt.Errorf("failed to write JSON file: %w", err)
+	for start := 0; start < len(prs); start += batchSize {
+		end := start + batchSize
+		if end > len(prs) {
+			end = len(prs)
+		}
+		var blocks []slackBlock
+		for i, pr := range prs[start:end] {
+			if i > 0 {
+				blocks = append(blocks, slackBlock{Type: "divider"})
+			}
+			blocks = append(blocks, prSlackBlocks(pr)...)
+		}
+		text := fmt.Sprintf("PRs %d-%d of %d", start+1, end, len(prs))
+		if _, err := poster.post(text, blocks, threadTS); err != nil {
+			return err
+		}
 	}
 
-	fmt.Printf("✅ Exported %d pull requests to %s\n", len(prs), filename)
+	fmt.Printf("✅ Posted %d PRs to a Slack thread in %s\n", len(prs), cfg.SlackChannel)
 	return nil
 }
 
-// exportToCSV exports pull requests to a CSV file
-func exportToCSV(prs []PullRequest, filename string) error {
-	if len(prs) == 0 {
-		fmt.Println("No pull requests to export")
-		return nil
+// getTeamMemberships fetches the teams of an organization once and maps each
+// member login to the sorted names of the teams they belong to
+func getTeamMemberships(token, org string) (map[string][]string, error) {
+	query := `
+	query GetTeamMemberships($org: String!) {
+		organization(login: $org) {
+			teams(first: 100) {
+				nodes {
+					name
+					members(first: 100) {
+						nodes {
+							login
+						}
+					}
+				}
+			}
+		}
 	}
+	`
 
-	file, err := os.Create(filename)
+	resp, err := makeGraphQLRequest(token, query, map[string]interface{}{"org": org})
 	if err != nil {
-		return fmt.Errorf("failed to create CSV file: %w", err)
+		return nil, fmt.Errorf("failed to fetch teams for %s: %w", org, err)
+	}
+	if resp.Data.Organization == nil {
+		return nil, fmt.Errorf("organization %s not found", org)
 	}
-	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+	memberships := make(map[string][]string)
+	for _, team := range resp.Data.Organization.Teams.Nodes {
+		for _, member := range team.Members.Nodes {
+			memberships[member.Login] = append(memberships[member.Login], team.Name)
+		}
+	}
+	for _, teams := range memberships {
+		sort.Strings(teams)
+	}
+	return memberships, nil
+}
 
-	header := []string{
-		"Repository", "PR#", "Title", "URL", "Branch", "State",
-		"Merged At", "Created At", "Updated At",
-		"Additions", "Deletions", "Changed Files",
-		"Reviews", "Comments", "Labels",
+// requireToken returns GITHUB_TOKEN, exiting with setup instructions if it is unset
+func requireToken() string {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		fmt.Println("\n❌ Error: GITHUB_TOKEN environment variable not set!")
+		fmt.Println("\nTo set your token:")
+		fmt.Println("  1. Go to GitHub Settings > Developer settings > Personal access tokens")
+		fmt.Println("  2. Create a new token with 'repo' scope")
+		fmt.Println("  3. Set it as an environment variable:")
+		fmt.Println("     export GITHUB_TOKEN='your_token_here'")
+		os.Exit(1)
 	}
-	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+	return token
+}
+
+// parseFlags parses command-line flags into a Config
+func parseFlags() *Config {
+	cfg := &Config{}
+	flag.BoolVar(&cfg.Preview, "preview", false, "fetch only the first page and print a sample of each export format without writing files")
+	flag.BoolVar(&cfg.NoPagination, "no-pagination", false, "make a single request for the first 5 PRs, as a credentials and field-mapping smoke test")
+	flag.BoolVar(&cfg.Milestone, "milestone", false, "only include PRs that belong to a milestone")
+	flag.BoolVar(&cfg.NoMilestone, "no-milestone", false, "only include PRs that do not belong to a milestone")
+	flag.StringVar(&cfg.Org, "org", "", "restrict the search to repositories owned by this organization")
+	flag.BoolVar(&cfg.OrgStats, "org-stats", false, "aggregate merged PRs from every author in --org (token needs read:org scope)")
+	flag.BoolVar(&cfg.WaitOnRateLimit, "wait-on-rate-limit", false, "sleep until the GraphQL rate limit resets once 80% of the hourly budget is used")
+	flag.BoolVar(&cfg.Interactive, "interactive", false, "browse PRs in a scrollable terminal view (falls back to the static table when not a TTY)")
+	flag.BoolVar(&cfg.LintCommits, "lint-commits", false, "score the first 20 commit messages of each PR and report the lowest-scoring PRs")
+	flag.BoolVar(&cfg.ProjectBoards, "project-boards", false, "fetch the Projects (V2) board and Status of each PR (token needs read:project)")
+	flag.BoolVar(&cfg.DetectCherryPicks, "detect-cherry-picks", false, "fetch commit messages, flag PRs with \"(cherry picked from commit ...)\" trailers and export them to cherry_picks.csv")
+	flag.BoolVar(&cfg.DetectCoauthors, "detect-coauthors", false, "fetch commit messages and detect Co-authored-by trailers")
+	flag.Var(&cfg.RepoTopics, "repo-topic", "only include PRs from repositories tagged with this topic (repeatable)")
+	flag.IntVar(&cfg.MinBodyWords, "min-body-words", 0, "only include PRs whose description has at least this many words")
+	flag.IntVar(&cfg.MinApprovals, "min-approvals", 0, "only include PRs with at least this many approving reviews")
+	flag.BoolVar(&cfg.EnrichTeams, "enrich-teams", false, "add each author's --org team memberships to the JSON export (token needs read:org scope)")
+	flag.BoolVar(&cfg.BranchDivergence, "branch-divergence", false, "compare each PR's head with its base through the REST API to measure how far behind the branch was")
+	flag.BoolVar(&cfg.GitHubActions, "github-actions", false, "fetch each repository's pull_request workflow runs from the REST API and link those on each PR's final commit")
+	flag.BoolVar(&cfg.ExcludeFailing, "exclude-failing-ci", false, "drop PRs whose head commit had failing checks when merged")
+	flag.BoolVar(&cfg.RequireLinked, "require-linked-issue", false, "only include PRs whose description closes an issue (Closes #123)")
+	flag.BoolVar(&cfg.ExcludeReopened, "exclude-reopened", false, "drop PRs that were closed and reopened at least once")
+	flag.BoolVar(&cfg.ExcludeReverts, "exclude-reverts", false, "drop PRs whose title starts with \"Revert \"")
+	flag.BoolVar(&cfg.FlagSelfMerges, "flag-self-merges", false, "export PRs merged by their own author to self_merges.csv")
+	flag.BoolVar(&cfg.FlagBypasses, "flag-bypasses", false, "export PRs merged by someone other than the author without approval to bypass_warnings.csv")
+	flag.StringVar(&cfg.DeployBranch, "deploy-branch", "main", "base branch whose merges count as deploys for deploy_frequency.csv")
+	flag.BoolVar(&cfg.DraftConverted, "draft-conversion-only", false, "only include PRs that were opened as drafts and later marked ready for review")
+	flag.BoolVar(&cfg.ExcludeConflicts, "exclude-conflict-prs", false, "fetch commit messages and drop PRs with a merge commit that kept git's Conflicts: list")
+	flag.BoolVar(&cfg.ProtectedOnly, "protected-only", false, "only include PRs merged into a branch covered by a protection rule")
+	flag.StringVar(&cfg.MentionFilter, "mention-filter", "", "only include PRs whose description @-mentions this login")
+	flag.StringVar(&cfg.ReviewedBy, "reviewed-by", "", "only include PRs this login was asked to review or reviewed")
+	flag.BoolVar(&cfg.OnlyReverts, "only-reverts", false, "only include PRs whose title starts with \"Revert \"")
+	flag.BoolVar(&cfg.ExcludeForks, "exclude-forks", false, "only include PRs merged into canonical (non-fork) repositories")
+	flag.BoolVar(&cfg.ExcludeArchived, "exclude-archived-repos", false, "drop PRs merged into repositories that have since been archived")
+	flag.BoolVar(&cfg.ContributorRank, "contributor-rank", false, "estimate your rank among each repository's contributors and export contributor_rank.csv")
+	flag.StringVar(&cfg.DotOut, "dot-out", "", "write a Graphviz DOT graph of repositories linked by shared collaborators to this file")
+	flag.StringVar(&cfg.SlackChannel, "slack-channel", "", "post each PR to this Slack channel (requires SLACK_BOT_TOKEN)")
+	flag.IntVar(&cfg.SlackBatchSize, "slack-batch-size", 0, "post a summary message to --slack-channel with PRs threaded beneath it, this many per reply (at most 16)")
+	fields := flag.String("fields", "", "comma-separated compactPR JSON field names to export as CSV columns, in order")
+	timezone := flag.String("timezone", "UTC", "IANA time zone used to bucket merge times for the heatmap")
+	flag.StringVar(&cfg.Topic, "topic", "", "only include PRs classified into this topic (feature, fix, refactor, test, docs, ci, chore or other)")
+	topicPatterns := flag.String("topic-patterns", "", "JSON file of {\"pattern\": \"regexp\", \"topic\": \"name\"} entries that replace the default topic patterns")
+	branchPattern := flag.String("branch-pattern", "", "regular expression that head branch names must match (e.g. ^(feat|fix|chore)/)")
+	flag.Parse()
+
+	if cfg.SlackBatchSize > maxSlackPRsPerMessage {
+		fmt.Printf("❌ Error: --slack-batch-size must be at most %d (Slack's block limit per message)\n", maxSlackPRsPerMessage)
+		os.Exit(1)
 	}
 
-	for _, pr := range prs {
-		labels := make([]string, len(pr.Labels.Nodes))
-		for i, l := range pr.Labels.Nodes {
-			labels[i] = l.Name
+	loc, err := time.LoadLocation(*timezone)
+	if err != nil {
+		fmt.Printf("❌ Error: invalid --timezone %q: %v\n", *timezone, err)
+		os.Exit(1)
+	}
+	cfg.Location = loc
+
+	cfg.TopicPatterns = defaultTopicPatterns
+	if *topicPatterns != "" {
+		patterns, err := readTopicPatterns(*topicPatterns)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --topic-patterns: %v\n", err)
+			os.Exit(1)
 		}
-		labelsStr := strings.Join(labels, "; ")
+		cfg.TopicPatterns = patterns
+	}
 
-		row := []string{
-			repoFullName(pr.Repository),
-			fmt.Sprintf("%d", pr.Number),
-			pr.Title,
-			pr.URL,
-			pr.HeadRefName,
-			pr.State,
-			formatDate(pr.MergedAt),
-			formatDateString(pr.CreatedAt),
-			formatDateString(pr.UpdatedAt),
-			fmt.Sprintf("%d", pr.Additions),
-			fmt.Sprintf("%d", pr.Deletions),
-			fmt.Sprintf("%d", pr.ChangedFiles),
-			fmt.Sprintf("%d", pr.Reviews.TotalCount),
-			fmt.Sprintf("%d", pr.Comments.TotalCount),
-			labelsStr,
+	if *branchPattern != "" {
+		re, err := regexp.Compile(*branchPattern)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --branch-pattern: %v\n", err)
+			os.Exit(1)
 		}
+		cfg.BranchPattern = re
+	}
 
-		if err := writer.Write(row); err != nil {
-			return fmt.Errorf("failed to write CSV row: %w", err)
+	if *fields != "" {
+		parsed, err := parseFields(*fields)
+		if err != nil {
+			fmt.Printf("❌ Error: invalid --fields: %v\n", err)
+			os.Exit(1)
 		}
+		cfg.Fields = parsed
 	}
 
-	fmt.Printf("✅ Exported %d pull requests to %s\n", len(prs), filename)
-	return nil
+	return cfg
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "orgs" {
+		runOrgs(requireToken())
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "ratelimit" {
+		runRateLimit(requireToken())
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "org-report" {
+		runOrgReport(requireToken(), os.Args[2:])
+		return
+	}
+
+	cfg := parseFlags()
+
+	if cfg.Milestone && cfg.NoMilestone {
+		fmt.Println("❌ Error: --milestone and --no-milestone cannot be used together")
+		os.Exit(1)
+	}
+	if cfg.ExcludeReverts && cfg.OnlyReverts {
+		fmt.Println("❌ Error: --exclude-reverts and --only-reverts cannot be used together")
+		os.Exit(1)
+	}
+	if cfg.OrgStats && cfg.Org == "" {
+		fmt.Println("❌ Error: --org-stats requires --org")
+		os.Exit(1)
+	}
+	if cfg.EnrichTeams && cfg.Org == "" {
+		fmt.Println("❌ Error: --enrich-teams requires --org")
+		os.Exit(1)
+	}
+
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Println("GitHub Merged Pull Requests Extractor")
 	fmt.Println(strings.Repeat("=", 60))
 
-	token := os.Getenv("GITHUB_TOKEN")
-	if token == "" {
-		fmt.Println("\n❌ Error: GITHUB_TOKEN environment variable not set!")
-		fmt.Println("\nTo set your token:")
-		fmt.Println("  1. Go to GitHub Settings > Developer settings > Personal access tokens")
-		fmt.Println("  2. Create a new token with 'repo' scope")
-		fmt.Println("  3. Set it as an environment variable:")
-		fmt.Println("     export GITHUB_TOKEN='your_token_here'")
+	token := requireToken()
+
+	slackToken := os.Getenv("SLACK_BOT_TOKEN")
+	if cfg.SlackChannel != "" && slackToken == "" {
+		fmt.Println("\n❌ Error: --slack-channel requires the SLACK_BOT_TOKEN environment variable")
 		os.Exit(1)
 	}
 
 	fmt.Printf("\n📅 Searching for merged PRs from %s to %s\n\n", startDateDisplay, endDateDisplay)
+	fmt.Printf("🔎 Query: %s\n\n", buildSearchQuery(cfg))
 
-	prs, err := getMergedPullRequests(token)
+	tracker := &queryCostTracker{}
+	prs, err := getMergedPullRequests(token, cfg, tracker)
 	if err != nil {
 		fmt.Printf("❌ Error fetching pull requests: %v\n", err)
 		os.Exit(1)
 	}
+	prs = filterPRs(prs, cfg)
+
+	if cfg.EnrichTeams {
+		memberships, err := getTeamMemberships(token, cfg.Org)
+		if err != nil {
+			fmt.Printf("❌ Error fetching team memberships: %v\n", err)
+		}
+		cfg.AuthorTeams = memberships
+	}
+
+	if cfg.BranchDivergence {
+		fmt.Println("🌿 Comparing each PR's head with its base branch...")
+		cfg.Divergence = getDivergence(token, prs)
+	}
+
+	if cfg.GitHubActions {
+		fmt.Println("⚙️  Fetching GitHub Actions workflow runs...")
+		cfg.Workflows = getWorkflowResults(token, prs)
+	}
+
+	if cfg.OrgStats {
+		stats := computeOrgStats(prs)
+		printOrgStats(cfg.Org, stats)
+		fmt.Printf("\nGraphQL query cost: %d points\n", tracker.TotalCost)
+		if len(stats) > 0 {
+			fmt.Println("\n📁 Exporting to files...")
+			if err := exportOrgStatsToCSV(stats, "org_pr_stats.csv"); err != nil {
+				fmt.Printf("❌ Error exporting org stats CSV: %v\n", err)
+			}
+		}
+		return
+	}
+
+	if cfg.Preview {
+		if err := printPreview(prs, cfg); err != nil {
+			fmt.Printf("❌ Error printing preview: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	printPRsTable(prs)
-	printSummary(prs)
+	showPRs(prs, cfg)
+	printSummary(prs, cfg, tracker)
 
 	if len(prs) > 0 {
 		fmt.Println("\n📁 Exporting to files...")
 
-		if err := exportToJSON(prs, "pull_requests_merged.json"); err != nil {
+		if err := exportToJSON(prs, "pull_requests_merged.json", cfg); err != nil {
 			fmt.Printf("❌ Error exporting JSON: %v\n", err)
 		}
 
-		if err := exportToCSV(prs, "pull_requests_merged.csv"); err != nil {
+		if err := exportToCSV(prs, "pull_requests_merged.csv", cfg); err != nil {
 			fmt.Printf("❌ Error exporting CSV: %v\n", err)
 		}
 
+		if err := exportToMarkdown(prs, "pull_requests_merged.md"); err != nil {
+			fmt.Printf("❌ Error exporting Markdown: %v\n", err)
+		}
+
+		if violations := nonCompliantBranches(prs, cfg); len(violations) > 0 {
+			if err := exportToCSV(violations, "branch_violations.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting branch violations CSV: %v\n", err)
+			}
+		}
+
+		if stats := computeReviewerStats(prs); len(stats) > 0 {
+			if err := exportReviewerStatsToCSV(stats, "reviewer_stats.csv"); err != nil {
+				fmt.Printf("❌ Error exporting reviewer stats CSV: %v\n", err)
+			}
+		}
+
+		if err := exportHeatmapToCSV(computeDayHourHeatmap(prs, cfg.Location), "merge_heatmap.csv"); err != nil {
+			fmt.Printf("❌ Error exporting merge heatmap CSV: %v\n", err)
+		}
+
+		if freq := computeDeployFrequency(prs, cfg.DeployBranch); len(freq) > 0 {
+			if err := exportDeployFrequencyToCSV(freq, "deploy_frequency.csv"); err != nil {
+				fmt.Printf("❌ Error exporting deploy frequency CSV: %v\n", err)
+			}
+		}
+
+		if err := exportDORAToJSON(computeDORA(prs, cfg.DeployBranch), "dora_metrics.json"); err != nil {
+			fmt.Printf("❌ Error exporting DORA metrics JSON: %v\n", err)
+		}
+
+		if stats := computeRepoReviewStats(prs); len(stats) > 0 {
+			if err := exportRepoReviewStatsToCSV(stats, "repo_review_stats.csv"); err != nil {
+				fmt.Printf("❌ Error exporting repository review stats CSV: %v\n", err)
+			}
+			if err := exportSizeReviewCorrelationToCSV(prs, "size_review_correlation.csv"); err != nil {
+				fmt.Printf("❌ Error exporting size/review correlation CSV: %v\n", err)
+			}
+		}
+
+		if selfMerges := selfMergedPRs(prs); cfg.FlagSelfMerges && len(selfMerges) > 0 {
+			if err := exportToCSV(selfMerges, "self_merges.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting self-merges CSV: %v\n", err)
+			}
+		}
+
+		if bypasses := bypassPRs(prs); cfg.FlagBypasses && len(bypasses) > 0 {
+			if err := exportToCSV(bypasses, "bypass_warnings.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting bypass warnings CSV: %v\n", err)
+			}
+		}
+
+		if picks := cherryPickPRs(prs); cfg.DetectCherryPicks && len(picks) > 0 {
+			if err := exportToCSV(picks, "cherry_picks.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting cherry-picks CSV: %v\n", err)
+			}
+		}
+
+		if reverts := revertPRs(prs); len(reverts) > 0 {
+			if err := exportToCSV(reverts, "reverts.csv", cfg); err != nil {
+				fmt.Printf("❌ Error exporting reverts CSV: %v\n", err)
+			}
+		}
+
+		if cfg.ContributorRank {
+			ranks := computeContributorRanks(token, prs)
+			if err := exportContributorRankToCSV(ranks, "contributor_rank.csv"); err != nil {
+				fmt.Printf("❌ Error exporting contributor rank CSV: %v\n", err)
+			}
+		}
+
+		if cfg.DotOut != "" {
+			if err := exportToDOT(token, prs, cfg.DotOut); err != nil {
+				fmt.Printf("❌ Error exporting DOT graph: %v\n", err)
+			}
+		}
+
+		if cfg.SlackChannel != "" {
+			fmt.Println("\n📣 Posting to Slack...")
+			if err := exportToSlack(prs, slackToken, cfg); err != nil {
+				fmt.Printf("❌ Error posting to Slack: %v\n", err)
+			}
+		}
+
 		fmt.Println("\n✨ Done! Check the output files for full details.")
 	} else {
 		fmt.Println("\nNo merged pull requests found in the specified date range.")
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintCommitMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want float64
+	}{
+		{"empty", "", 0},
+		{"all rules", "feat(api): Add pagination", 1},
+		{"body is ignored", "fix: Handle empty cursor\nno blank line and " + strings.Repeat("x", 80), 1},
+		{"not conventional", "Add pagination", 2.0 / 3},
+		{"lowercase description", "feat: add pagination", 2.0 / 3},
+		{"71 characters", "feat: Add " + strings.Repeat("x", 61), 1},
+		{"72 characters", "feat: Add " + strings.Repeat("x", 62), 2.0 / 3},
+		{"only short", "added pagination", 1.0 / 3},
+		{"nothing passes", "added pagination " + strings.Repeat("x", 60), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lintCommitMessage(tt.msg)
+			if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("lintCommitMessage(%q) = %.3f, want %.3f", tt.msg, got, tt.want)
+			}
+		})
+	}
+}